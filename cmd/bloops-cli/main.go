@@ -11,10 +11,18 @@ import (
 	"github.com/bloops-games/bloops/internal/cache"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot"
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	"github.com/bloops-games/bloops/internal/database"
+	bloopsDb "github.com/bloops-games/bloops/internal/database/bloops/database"
+	builderStateDb "github.com/bloops-games/bloops/internal/database/builderstate/database"
+	gamelogDb "github.com/bloops-games/bloops/internal/database/gamelog/database"
+	leaderboardDb "github.com/bloops-games/bloops/internal/database/leaderboard/database"
 	stateDb "github.com/bloops-games/bloops/internal/database/matchstate/database"
+	packDb "github.com/bloops-games/bloops/internal/database/pack/database"
 	statDb "github.com/bloops-games/bloops/internal/database/stat/database"
 	userdb "github.com/bloops-games/bloops/internal/database/user/database"
+	"github.com/bloops-games/bloops/internal/dictionary"
 	"github.com/bloops-games/bloops/internal/logging"
 	"github.com/bloops-games/bloops/internal/server"
 	"github.com/bloops-games/bloops/internal/shutdown"
@@ -43,6 +51,14 @@ func main() {
 	}
 
 	logger := logging.NewLogger(config.Debug)
+	if len(os.Args) > 1 && os.Args[1] == replayCmd {
+		if err := replay(ctx, config, os.Args[2:]); err != nil {
+			logger.Fatalf("main.replay: %v", err)
+		}
+
+		return
+	}
+
 	if err := realMain(ctx, config, done); err != nil {
 		logger.Fatalf("main.realMain: %v", err)
 	}
@@ -113,6 +129,9 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 	}
 
 	tg.Debug = config.Debug
+	if config.BotUsername == "" {
+		config.BotUsername = tg.Self.UserName
+	}
 
 	_, _ = fmt.Fprint(os.Stdout, "Authorization in telegram was successful: ", tg.Self.UserName, "\n")
 
@@ -133,6 +152,16 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 		return fmt.Errorf("can not create lru cache: %w", err)
 	}
 
+	var dict dictionary.Dictionary
+	if config.DictionaryDir != "" {
+		dictCache, err := cache.NewLRU(config.CacheSize)
+		if err != nil {
+			return fmt.Errorf("can not create lru cache: %w", err)
+		}
+
+		dict = dictionary.NewFile(config.DictionaryDir, resource.CategoryDictionaries, dictCache)
+	}
+
 	srv, err := server.New(config.Port)
 	if err != nil {
 		return fmt.Errorf("server.New: %w", err)
@@ -155,7 +184,27 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 		}
 	}()
 
-	manager := bloopsbot.NewManager(tg, &config, userdb.New(db, userCache), statDb.New(db, statCache), stateDb.New(db))
+	telegram := transport.NewTelegram(tg, transport.TelegramConfig{
+		Token:       config.BotToken,
+		WebhookURL:  config.BotWebhookHookURL,
+		WebhookAddr: config.BotWebhookAddr,
+		PollTimeout: config.TgBotPollTimeout,
+	})
+
+	manager := bloopsbot.NewManager(
+		telegram,
+		telegram,
+		&config,
+		userdb.New(db, userCache),
+		statDb.New(db, statCache),
+		stateDb.New(db),
+		packDb.New(db),
+		bloopsDb.New(db),
+		gamelogDb.New(db),
+		builderStateDb.New(db),
+		leaderboardDb.New(db, config.LeaderboardSeasons),
+		dict,
+	)
 	if err := manager.Run(ctx); err != nil {
 		return fmt.Errorf("run: %w", err)
 	}
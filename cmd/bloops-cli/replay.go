@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot"
+	"github.com/bloops-games/bloops/internal/bloopsbot/match"
+	"github.com/bloops-games/bloops/internal/database"
+	gamelogDb "github.com/bloops-games/bloops/internal/database/gamelog/database"
+)
+
+const replayCmd = "replay"
+
+// replay re-derives the scores of the game from its log, without the log id it lists the stored game logs
+func replay(ctx context.Context, config bloopsbot.Config, args []string) error {
+	db, err := database.NewFromEnv(ctx, &config.DB)
+	if err != nil {
+		return fmt.Errorf("new database from env: %w", err)
+	}
+
+	defer db.Close(ctx)
+
+	logDB := gamelogDb.New(db)
+	if len(args) == 0 {
+		ids, err := logDB.FetchIDs()
+		if err != nil {
+			return fmt.Errorf("fetch game log ids: %w", err)
+		}
+
+		for _, id := range ids {
+			_, _ = fmt.Fprintln(os.Stdout, id)
+		}
+
+		return nil
+	}
+
+	events, err := logDB.Fetch(args[0])
+	if err != nil {
+		return fmt.Errorf("fetch game log: %w", err)
+	}
+
+	session, err := match.Replay(events)
+	if err != nil {
+		return fmt.Errorf("replay: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(os.Stdout, "Game %s, seed %d, events %d\n\n", args[0], session.Config.Seed, len(events))
+	for i, score := range session.Scores() {
+		_, _ = fmt.Fprintf(
+			os.Stdout,
+			"%d. %s - %d points, %d/%d rounds completed\n",
+			i+1,
+			score.Player.User.FirstName,
+			score.Points,
+			score.Completed,
+			score.Rounds,
+		)
+	}
+
+	return nil
+}
@@ -12,10 +12,18 @@ import (
 	"github.com/bloops-games/bloops/internal/cache"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot"
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	"github.com/bloops-games/bloops/internal/database"
+	bloopsDb "github.com/bloops-games/bloops/internal/database/bloops/database"
+	builderStateDb "github.com/bloops-games/bloops/internal/database/builderstate/database"
+	gamelogDb "github.com/bloops-games/bloops/internal/database/gamelog/database"
+	leaderboardDb "github.com/bloops-games/bloops/internal/database/leaderboard/database"
 	stateDb "github.com/bloops-games/bloops/internal/database/matchstate/database"
+	packDb "github.com/bloops-games/bloops/internal/database/pack/database"
 	statDb "github.com/bloops-games/bloops/internal/database/stat/database"
 	userdb "github.com/bloops-games/bloops/internal/database/user/database"
+	"github.com/bloops-games/bloops/internal/dictionary"
 	"github.com/bloops-games/bloops/internal/logging"
 	"github.com/bloops-games/bloops/internal/server"
 	"github.com/bloops-games/bloops/internal/shutdown"
@@ -64,6 +72,9 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 	}
 
 	tg.Debug = config.Debug
+	if config.BotUsername == "" {
+		config.BotUsername = tg.Self.UserName
+	}
 
 	_, _ = fmt.Fprint(os.Stdout, "Authorization in telegram was successful: ", tg.Self.UserName, "\n")
 
@@ -84,6 +95,44 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 		return fmt.Errorf("can not create lru cache: %w", err)
 	}
 
+	var dict dictionary.Dictionary
+	if config.DictionaryDir != "" {
+		dictCache, err := cache.NewLRU(config.CacheSize)
+		if err != nil {
+			return fmt.Errorf("can not create lru cache: %w", err)
+		}
+
+		dict = dictionary.NewFile(config.DictionaryDir, resource.CategoryDictionaries, dictCache)
+	}
+
+	go func() {
+		if err := http.ListenAndServe(":"+config.ProfPort, nil); err != nil {
+			logger.Fatalf("pprof default sever: %v", err)
+			done()
+		}
+	}()
+
+	telegram := transport.NewTelegram(tg, transport.TelegramConfig{
+		Token:       config.BotToken,
+		WebhookURL:  config.BotWebhookHookURL,
+		WebhookAddr: config.BotWebhookAddr,
+		PollTimeout: config.TgBotPollTimeout,
+	})
+
+	manager := bloopsbot.NewManager(
+		telegram,
+		telegram,
+		&config,
+		userdb.New(db, userCache),
+		statDb.New(db, statCache),
+		stateDb.New(db),
+		packDb.New(db),
+		bloopsDb.New(db),
+		gamelogDb.New(db),
+		builderStateDb.New(db),
+		leaderboardDb.New(db, config.LeaderboardSeasons),
+		dict,
+	)
 	srv, err := server.New(config.Port)
 	if err != nil {
 		return fmt.Errorf("server.New: %w", err)
@@ -92,6 +141,13 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 	mux := http.NewServeMux()
 	mux.Handle("/health", server.HandleHealth(ctx))
 
+	creds := server.Credentials{Username: config.APIUsername, Password: config.APIPassword, Token: config.APIToken}
+	if !creds.Empty() {
+		mux.Handle("/api/", server.HandleAuth(creds, manager.APIHandler(ctx)))
+	} else {
+		logger.Infof("REST API credentials are not set, the API is disabled")
+	}
+
 	go func() {
 		if err := srv.ServeHTTP(ctx, &http.Server{Handler: mux}); err != nil {
 			logger.Fatalf("srv.ServeHTTP: %v", err)
@@ -99,14 +155,6 @@ func realMain(ctx context.Context, config bloopsbot.Config, done func()) error {
 		}
 	}()
 
-	go func() {
-		if err := http.ListenAndServe(":"+config.ProfPort, nil); err != nil {
-			logger.Fatalf("pprof default sever: %v", err)
-			done()
-		}
-	}()
-
-	manager := bloopsbot.NewManager(tg, &config, userdb.New(db, userCache), statDb.New(db, statCache), stateDb.New(db))
 	if err := manager.Run(ctx); err != nil {
 		return fmt.Errorf("run: %w", err)
 	}
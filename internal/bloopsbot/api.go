@@ -0,0 +1,417 @@
+package bloopsbot
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/builder"
+	"github.com/bloops-games/bloops/internal/bloopsbot/match"
+	leaderboardDb "github.com/bloops-games/bloops/internal/database/leaderboard/database"
+	leaderboardModel "github.com/bloops-games/bloops/internal/database/leaderboard/model"
+	statDb "github.com/bloops-games/bloops/internal/database/stat/database"
+	statModel "github.com/bloops-games/bloops/internal/database/stat/model"
+	userDb "github.com/bloops-games/bloops/internal/database/user/database"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+	"github.com/bloops-games/bloops/internal/logging"
+)
+
+var (
+	errAPINotFound         = fmt.Errorf("not found")
+	errAPIMethodNotAllowed = fmt.Errorf("method not allowed")
+	errAPIBadRequest       = fmt.Errorf("bad request")
+)
+
+var matchStateNames = map[uint8]string{
+	match.StateKindWaiting:    "waiting",
+	match.StateKindPlaying:    "playing",
+	match.StateKindProcessing: "processing",
+	match.StateKindFinished:   "finished",
+	match.StateKindPaused:     "paused",
+}
+
+type apiPlayer struct {
+	UserID    int64         `json:"userId"`
+	FirstName string        `json:"firstName"`
+	Username  string        `json:"username"`
+	Offline   bool          `json:"offline"`
+	Playing   bool          `json:"playing"`
+	Points    int           `json:"points"`
+	Completed int           `json:"completed"`
+	Rounds    int           `json:"rounds"`
+	Duration  time.Duration `json:"duration"`
+	Team      int           `json:"team,omitempty"`
+}
+
+type apiGame struct {
+	Code       string      `json:"code"`
+	AuthorID   int64       `json:"authorId"`
+	AuthorName string      `json:"authorName"`
+	ChatID     int64       `json:"chatId"`
+	Group      bool        `json:"group"`
+	Locale     string      `json:"locale"`
+	State      string      `json:"state"`
+	Round      int         `json:"round"`
+	RoundsNum  int         `json:"roundsNum"`
+	Players    []apiPlayer `json:"players"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	Teams      []string    `json:"teams,omitempty"`
+}
+
+type apiBuilder struct {
+	AuthorID   int64     `json:"authorId"`
+	AuthorName string    `json:"authorName"`
+	ChatID     int64     `json:"chatId"`
+	Group      bool      `json:"group"`
+	Locale     string    `json:"locale"`
+	Stage      uint8     `json:"stage"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type apiSessions struct {
+	Games    []apiGame    `json:"games"`
+	Builders []apiBuilder `json:"builders"`
+}
+
+type apiStats struct {
+	Games         int           `json:"games"`
+	Stars         int           `json:"stars"`
+	Bloops        []string      `json:"bloops"`
+	AvgDuration   time.Duration `json:"avgDuration"`
+	BestDuration  time.Duration `json:"bestDuration"`
+	WorstDuration time.Duration `json:"worstDuration"`
+	AvgPoints     int           `json:"avgPoints"`
+	BestPoints    int           `json:"bestPoints"`
+	WorstPoints   int           `json:"worstPoints"`
+}
+
+type apiLeaderboard struct {
+	Season  string                   `json:"season"`
+	Metric  leaderboardModel.Metric  `json:"metric"`
+	Entries []leaderboardModel.Entry `json:"entries"`
+}
+
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// APIHandler serves the admin REST API:
+//
+//	GET  /api/sessions - active games and game settings
+//	GET  /api/games/{code} - the active game by the code the players see
+//	POST /api/games/{code}/finish - finish the game early
+//	GET  /api/users/{id or username} - the user
+//	POST /api/users/{id or username}/ban, /unban - ban or unban the user, the banned user leaves the active game and setting
+//	GET  /api/users/{id or username}/stats - the aggregated game statistics of the user
+//	GET  /api/leaderboards - the seasons of the leaderboards
+//	GET  /api/leaderboards/{season}/{metric}?limit=N - the leaderboard, the season is all, current or YYYY-MM
+func (m *manager) APIHandler(ctx context.Context) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/sessions", apiFunc(ctx, m.handleAPISessions))
+	mux.HandleFunc("/api/games/", apiFunc(ctx, m.handleAPIGames))
+	mux.HandleFunc("/api/users/", apiFunc(ctx, m.handleAPIUsers))
+	mux.HandleFunc("/api/leaderboards", apiFunc(ctx, m.handleAPILeaderboards))
+	mux.HandleFunc("/api/leaderboards/", apiFunc(ctx, m.handleAPILeaderboards))
+
+	return mux
+}
+
+type apiHandlerFunc func(r *http.Request) (interface{}, error)
+
+// apiFunc encodes the response of the handler to json, the errors are encoded with the matching status code
+func apiFunc(ctx context.Context, fn apiHandlerFunc) http.HandlerFunc {
+	logger := logging.FromContext(ctx).Named("manager.api")
+	return func(w http.ResponseWriter, r *http.Request) {
+		resp, err := fn(r)
+		status := http.StatusOK
+		if err != nil {
+			switch {
+			case errors.Is(err, errAPINotFound):
+				status = http.StatusNotFound
+			case errors.Is(err, errAPIMethodNotAllowed):
+				status = http.StatusMethodNotAllowed
+			case errors.Is(err, errAPIBadRequest):
+				status = http.StatusBadRequest
+			default:
+				logger.Errorf("%s %s: %v", r.Method, r.URL.Path, err)
+				status = http.StatusInternalServerError
+			}
+
+			resp = apiError{Error: err.Error()}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		if err := json.NewEncoder(w).Encode(resp); err != nil {
+			logger.Errorf("json encode: %v", err)
+		}
+	}
+}
+
+// apiPath splits the path after the prefix into the resource id and the action
+func apiPath(r *http.Request, prefix string) (string, string) {
+	parts := strings.SplitN(strings.Trim(strings.TrimPrefix(r.URL.Path, prefix), "/"), "/", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+
+	return parts[0], parts[1]
+}
+
+func (m *manager) handleAPISessions(r *http.Request) (interface{}, error) {
+	if r.Method != http.MethodGet {
+		return nil, errAPIMethodNotAllowed
+	}
+
+	m.mtx.RLock()
+	games := make([]*match.Session, 0, len(m.matchSessions))
+	for _, session := range m.matchSessions {
+		games = append(games, session)
+	}
+
+	builders := make([]*builder.Session, 0, len(m.userBuildingSessions))
+	for _, session := range m.userBuildingSessions {
+		builders = append(builders, session)
+	}
+	m.mtx.RUnlock()
+
+	resp := apiSessions{Games: make([]apiGame, 0, len(games)), Builders: make([]apiBuilder, 0, len(builders))}
+	for _, session := range games {
+		resp.Games = append(resp.Games, m.renderAPIGame(session))
+	}
+
+	for _, session := range builders {
+		resp.Builders = append(resp.Builders, apiBuilder{
+			AuthorID:   session.AuthorID,
+			AuthorName: session.AuthorName,
+			ChatID:     session.ChatID,
+			Group:      session.Group,
+			Locale:     session.Locale,
+			Stage:      session.Stage(),
+			CreatedAt:  session.CreatedAt,
+		})
+	}
+
+	sort.Slice(resp.Games, func(i, j int) bool {
+		return resp.Games[i].CreatedAt.Before(resp.Games[j].CreatedAt)
+	})
+	sort.Slice(resp.Builders, func(i, j int) bool {
+		return resp.Builders[i].CreatedAt.Before(resp.Builders[j].CreatedAt)
+	})
+
+	return resp, nil
+}
+
+func (m *manager) handleAPIGames(r *http.Request) (interface{}, error) {
+	id, action := apiPath(r, "/api/games/")
+	code, ok := m.codes.Parse(id)
+	if !ok {
+		return nil, fmt.Errorf("game %s: %w", id, errAPINotFound)
+	}
+
+	session, ok := m.matchSession(code)
+	if !ok {
+		return nil, fmt.Errorf("game %s: %w", id, errAPINotFound)
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+	case action == "finish" && r.Method == http.MethodPost:
+		// only the started games can be finished, the lobby and the finished games are left as is
+		state, _ := session.Progress()
+		if state != match.StateKindPlaying && state != match.StateKindProcessing && state != match.StateKindPaused {
+			return nil, fmt.Errorf("game %s is %s: %w", id, matchStateNames[state], errAPIBadRequest)
+		}
+
+		session.Finish()
+	case action == "" || action == "finish":
+		return nil, errAPIMethodNotAllowed
+	default:
+		return nil, fmt.Errorf("action %s: %w", action, errAPINotFound)
+	}
+
+	return m.renderAPIGame(session), nil
+}
+
+func (m *manager) handleAPIUsers(r *http.Request) (interface{}, error) {
+	id, action := apiPath(r, "/api/users/")
+	u, err := m.fetchAPIUser(id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case action == "" && r.Method == http.MethodGet:
+		return u, nil
+	case action == "stats" && r.Method == http.MethodGet:
+		stat, err := m.statDB.FetchProfileStat(u.ID)
+		if errors.Is(err, statDb.ErrNotFound) {
+			return nil, fmt.Errorf("stats of the user %d: %w", u.ID, errAPINotFound)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("fetch profile stat: %w", err)
+		}
+
+		return renderAPIStats(stat), nil
+	case (action == "ban" || action == "unban") && r.Method == http.MethodPost:
+		if u.Admin {
+			return nil, fmt.Errorf("admin can not be banned: %w", errAPIBadRequest)
+		}
+
+		u.Status = userModel.StatusActive
+		if action == "ban" {
+			u.Status = userModel.StatusBanned
+		}
+
+		if err := m.userDB.Store(u); err != nil {
+			return nil, fmt.Errorf("user db store: %w", err)
+		}
+
+		if action == "ban" {
+			m.detachUser(u.ID)
+		}
+
+		return u, nil
+	case action == "" || action == "stats" || action == "ban" || action == "unban":
+		return nil, errAPIMethodNotAllowed
+	default:
+		return nil, fmt.Errorf("action %s: %w", action, errAPINotFound)
+	}
+}
+
+func (m *manager) handleAPILeaderboards(r *http.Request) (interface{}, error) {
+	if r.Method != http.MethodGet {
+		return nil, errAPIMethodNotAllowed
+	}
+
+	season, metric := apiPath(r, "/api/leaderboards")
+	if season == "" {
+		seasons, err := m.leaderboardDB.Seasons()
+		if err != nil {
+			return nil, fmt.Errorf("leaderboard db seasons: %w", err)
+		}
+
+		if seasons == nil {
+			seasons = []string{}
+		}
+
+		return seasons, nil
+	}
+
+	if season == "current" {
+		season = leaderboardModel.Season(time.Now())
+	}
+
+	if metric == "" {
+		metric = string(leaderboardModel.MetricStars)
+	}
+
+	limit := 100
+	if v := r.URL.Query().Get("limit"); v != "" {
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 1 {
+			return nil, fmt.Errorf("limit %s: %w", v, errAPIBadRequest)
+		}
+
+		limit = n
+	}
+
+	entries, err := m.leaderboardDB.Top(season, leaderboardModel.Metric(metric), limit)
+	if err != nil {
+		switch {
+		case errors.Is(err, leaderboardDb.ErrUnknownMetric):
+			return nil, fmt.Errorf("metric %s: %w", metric, errAPINotFound)
+		case errors.Is(err, leaderboardDb.ErrNotFound):
+			return nil, fmt.Errorf("season %s: %w", season, errAPINotFound)
+		default:
+			return nil, fmt.Errorf("leaderboard db top: %w", err)
+		}
+	}
+
+	if entries == nil {
+		entries = []leaderboardModel.Entry{}
+	}
+
+	return apiLeaderboard{Season: season, Metric: leaderboardModel.Metric(metric), Entries: entries}, nil
+}
+
+// fetchAPIUser finds the user by the id or by the username
+func (m *manager) fetchAPIUser(id string) (userModel.User, error) {
+	var (
+		u   userModel.User
+		err error
+	)
+
+	if userID, parseErr := strconv.ParseInt(id, 10, 64); parseErr == nil {
+		u, err = m.userDB.Fetch(userID)
+	} else {
+		u, err = m.userDB.FetchByUsername(strings.TrimPrefix(id, "@"))
+	}
+
+	if err != nil {
+		if errors.Is(err, userDb.ErrNotFound) {
+			return u, fmt.Errorf("user %s: %w", id, errAPINotFound)
+		}
+
+		return u, fmt.Errorf("fetch user: %w", err)
+	}
+
+	return u, nil
+}
+
+func (m *manager) renderAPIGame(session *match.Session) apiGame {
+	state, round := session.Progress()
+	game := apiGame{
+		Code:       m.codes.Format(session.Config.Code),
+		AuthorID:   session.Config.AuthorID,
+		AuthorName: session.Config.AuthorName,
+		ChatID:     session.Config.ChatID,
+		Group:      session.Config.IsGroup(),
+		Locale:     session.Config.Locale,
+		State:      matchStateNames[state],
+		Round:      round + 1,
+		RoundsNum:  session.Config.RoundsNum,
+		CreatedAt:  session.CreatedAt,
+		Teams:      session.Config.Teams,
+	}
+
+	scores := session.Scores()
+	game.Players = make([]apiPlayer, 0, len(scores))
+	for _, score := range scores {
+		game.Players = append(game.Players, apiPlayer{
+			UserID:    score.Player.UserID,
+			FirstName: score.Player.User.FirstName,
+			Username:  score.Player.User.Username,
+			Offline:   score.Player.Offline,
+			Playing:   score.Player.IsPlaying(),
+			Points:    score.Points,
+			Completed: score.Completed,
+			Rounds:    score.Rounds,
+			Duration:  score.TotalDuration,
+			Team:      score.Player.Team,
+		})
+	}
+
+	return game
+}
+
+func renderAPIStats(stat statModel.AggregationStat) apiStats {
+	return apiStats{
+		Games:         stat.Count,
+		Stars:         stat.Stars,
+		Bloops:        stat.Bloops,
+		AvgDuration:   stat.AvgDuration,
+		BestDuration:  stat.BestDuration,
+		WorstDuration: stat.WorstDuration,
+		AvgPoints:     stat.AvgPoints,
+		BestPoints:    stat.BestPoints,
+		WorstPoints:   stat.WorstPoints,
+	}
+}
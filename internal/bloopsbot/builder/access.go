@@ -0,0 +1,103 @@
+package builder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	privatePINDeleteData   = "pin:del"
+	publicMaxPlayersPrefix = "max:"
+)
+
+func (bs *Session) clickOnPrivate(query *tgbotapi.CallbackQuery) error {
+	if query.Data == privatePINDeleteData {
+		bs.PIN = ""
+	} else {
+		value, err := strconv.ParseBool(query.Data)
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.setPrivate(value)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlinePrivate())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// setPIN sets the PIN typed by the author, the game with a PIN is private
+func (bs *Session) setPIN(pin string) error {
+	pin = strings.TrimSpace(pin)
+	if pin == "" || utf8.RuneCountInString(pin) > resource.MaxPINLen {
+		return nil
+	}
+
+	bs.PIN = pin
+	bs.setPrivate(true)
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlinePrivate())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// setPrivate makes the game private, the private game is not public and only the private game has a PIN
+func (bs *Session) setPrivate(value bool) {
+	bs.Private = value
+	if value {
+		bs.setPublic(false)
+	} else {
+		bs.PIN = ""
+	}
+}
+
+func (bs *Session) clickOnPublic(query *tgbotapi.CallbackQuery) error {
+	if strings.HasPrefix(query.Data, publicMaxPlayersPrefix) {
+		n, err := strconv.Atoi(strings.TrimPrefix(query.Data, publicMaxPlayersPrefix))
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.MaxPlayers = n
+	} else {
+		value, err := strconv.ParseBool(query.Data)
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.setPublic(value)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlinePublic())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// setPublic lists the game in the public games, the public game has a limit of the players and is not private
+func (bs *Session) setPublic(value bool) {
+	bs.Public = value
+	bs.MaxPlayers = 0
+	if value {
+		bs.MaxPlayers = resource.DefaultMaxPlayers
+		bs.setPrivate(false)
+	}
+}
@@ -0,0 +1,20 @@
+package builder
+
+import "testing"
+
+func TestSessionPublicPrivate(t *testing.T) {
+	t.Parallel()
+
+	bs := &Session{}
+	bs.PIN = "1234"
+	bs.setPrivate(true)
+	bs.setPublic(true)
+	if !bs.Public || bs.Private || bs.PIN != "" {
+		t.Fatalf("expected the public game without the PIN, got public %t, private %t, PIN %q", bs.Public, bs.Private, bs.PIN)
+	}
+
+	bs.setPrivate(true)
+	if bs.Public || !bs.Private || bs.MaxPlayers != 0 {
+		t.Fatalf("expected the private game not to be public, got public %t, private %t", bs.Public, bs.Private)
+	}
+}
@@ -0,0 +1,247 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	bloopsModel "github.com/bloops-games/bloops/internal/database/bloops/model"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	bloopsFieldSep   = ";"
+	bloopsRepeatData = "repeat"
+)
+
+var (
+	ErrBloopsFormat  = fmt.Errorf("invalid bloops format")
+	ErrBloopsPoints  = fmt.Errorf("bloops points out of range")
+	ErrBloopsSeconds = fmt.Errorf("bloops seconds out of range")
+	ErrBloopsWeight  = fmt.Errorf("bloops weight out of range")
+)
+
+// BloopsStore persists custom bloopses authored by users
+type BloopsStore interface {
+	Add(bloops bloopsModel.Bloops) (bloopsModel.Bloops, error)
+	FetchByAuthor(authorID int64) ([]bloopsModel.Bloops, error)
+}
+
+// BloopsOption is a bloops which can be included in the game
+type BloopsOption struct {
+	resource.Bloops
+	Status bool
+}
+
+// parseBloops parses a bloops from the text in the format: name; task; points; seconds; weight
+// seconds and weight are optional
+func parseBloops(text string) (resource.Bloops, error) {
+	var bloops resource.Bloops
+
+	fields := strings.Split(text, bloopsFieldSep)
+	if len(fields) < 3 || len(fields) > 5 {
+		return bloops, ErrBloopsFormat
+	}
+
+	for i := range fields {
+		fields[i] = strings.TrimSpace(fields[i])
+	}
+
+	bloops.Name, bloops.Task = fields[0], fields[1]
+	if bloops.Name == "" || bloops.Task == "" {
+		return bloops, ErrBloopsFormat
+	}
+
+	points, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return bloops, ErrBloopsFormat
+	}
+
+	if points < resource.MinBloopsPoints || points > resource.MaxBloopsPoints {
+		return bloops, ErrBloopsPoints
+	}
+
+	bloops.Points = points
+	bloops.Weight = resource.MinBloopsWeight
+
+	if len(fields) > 3 {
+		seconds, err := strconv.Atoi(fields[3])
+		if err != nil {
+			return bloops, ErrBloopsFormat
+		}
+
+		if seconds < resource.MinBloopsSeconds || seconds > resource.MaxBloopsSeconds {
+			return bloops, ErrBloopsSeconds
+		}
+
+		bloops.Seconds = seconds
+	}
+
+	if len(fields) > 4 {
+		weight, err := strconv.Atoi(fields[4])
+		if err != nil {
+			return bloops, ErrBloopsFormat
+		}
+
+		if weight < resource.MinBloopsWeight || weight > resource.MaxBloopsWeight {
+			return bloops, ErrBloopsWeight
+		}
+
+		bloops.Weight = weight
+	}
+
+	return bloops, nil
+}
+
+// setBloopses resets the bloopses to the locale ones and the custom ones of the author
+func (bs *Session) setBloopses() {
+	bs.Bloopses = make([]BloopsOption, 0, len(bs.lang.Bloopses)+len(bs.customBloopses))
+	for _, bloops := range bs.lang.Bloopses {
+		bs.Bloopses = append(bs.Bloopses, BloopsOption{Bloops: bloops, Status: true})
+	}
+
+	for _, custom := range bs.customBloopses {
+		if resource.Lang(custom.Locale) == bs.lang {
+			bs.Bloopses = append(bs.Bloopses, BloopsOption{Bloops: customToBloops(custom), Status: true})
+		}
+	}
+}
+
+func customToBloops(custom bloopsModel.Bloops) resource.Bloops {
+	return resource.Bloops{
+		Name:    custom.Name,
+		Task:    custom.Task,
+		Points:  custom.Points,
+		Seconds: custom.Seconds,
+		Weight:  custom.Weight,
+	}
+}
+
+func (bs *Session) clickOnBloopses(query *tgbotapi.CallbackQuery) error {
+	idx, err := strconv.Atoi(query.Data)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	if idx < 0 || idx >= len(bs.Bloopses) {
+		return fmt.Errorf("bloops with index %d not found", idx)
+	}
+
+	var answer string
+	bs.Bloopses[idx].Status = !bs.Bloopses[idx].Status
+	if bs.Bloopses[idx].Status {
+		answer = fmt.Sprintf(bs.lang.TextAddedBloops, bs.Bloopses[idx].Name)
+	} else {
+		answer = fmt.Sprintf(bs.lang.TextDeletedBloops, bs.Bloopses[idx].Name)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, answer); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineBloopses())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+func (bs *Session) clickOnBloopsChance(query *tgbotapi.CallbackQuery) error {
+	var answer string
+	if query.Data == bloopsRepeatData {
+		bs.BloopsRepeat = !bs.BloopsRepeat
+		answer = bs.bloopsRepeatText()
+	} else {
+		chance, err := strconv.Atoi(query.Data)
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.BloopsChance = chance
+		answer = fmt.Sprintf(bs.lang.TextBloopsChanceAnswer, chance)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, answer); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineBloopsChance())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+func (bs *Session) bloopsRepeatText() string {
+	if bs.BloopsRepeat {
+		return bs.lang.TextBloopsRepeatOn
+	}
+
+	return bs.lang.TextBloopsRepeatOff
+}
+
+// addCustomBloops parses the bloops sent by the author, saves it and includes it in the game
+func (bs *Session) addCustomBloops(text string) error {
+	bloops, err := parseBloops(text)
+	if err != nil {
+		var msg string
+		switch {
+		case errors.Is(err, ErrBloopsPoints):
+			msg = fmt.Sprintf(bs.lang.TextBloopsPointsInvalidMsg, resource.MinBloopsPoints, resource.MaxBloopsPoints)
+		case errors.Is(err, ErrBloopsSeconds):
+			msg = fmt.Sprintf(bs.lang.TextBloopsSecondsInvalidMsg, resource.MinBloopsSeconds, resource.MaxBloopsSeconds)
+		case errors.Is(err, ErrBloopsWeight):
+			msg = fmt.Sprintf(bs.lang.TextBloopsWeightInvalidMsg, resource.MinBloopsWeight, resource.MaxBloopsWeight)
+		default:
+			msg = bs.lang.TextBloopsFormatInvalidMsg
+		}
+
+		if _, err := bs.tg.SendText(transport.Message{ChatID: bs.ChatID, Text: msg}); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	custom := bloopsModel.Bloops{
+		AuthorID:  bs.AuthorID,
+		Locale:    bs.Locale,
+		Name:      bloops.Name,
+		Task:      bloops.Task,
+		Points:    bloops.Points,
+		Seconds:   bloops.Seconds,
+		Weight:    bloops.Weight,
+		CreatedAt: time.Now(),
+	}
+
+	if bs.bloopses != nil {
+		if custom, err = bs.bloopses.Add(custom); err != nil {
+			return fmt.Errorf("add bloops: %w", err)
+		}
+	}
+
+	bs.customBloopses = append(bs.customBloopses, custom)
+	bs.Bloopses = append(bs.Bloopses, BloopsOption{Bloops: bloops, Status: true})
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineBloopses())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+func (bs *Session) chooseBloopsesText() string {
+	return fmt.Sprintf(
+		bs.lang.TextChooseBloopses,
+		resource.MinBloopsPoints,
+		resource.MaxBloopsPoints,
+		resource.MinBloopsSeconds,
+		resource.MaxBloopsSeconds,
+		resource.MinBloopsWeight,
+		resource.MaxBloopsWeight,
+	)
+}
@@ -0,0 +1,46 @@
+package builder
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+)
+
+func TestParseBloops(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		text     string
+		expected resource.Bloops
+		err      error
+	}{
+		{
+			text:     "Flamingo; answer while standing on one leg; 10",
+			expected: resource.Bloops{Name: "Flamingo", Task: "answer while standing on one leg", Points: 10, Weight: 1},
+		},
+		{
+			text:     " Rush ; be quick ; 15 ; -10 ; 3 ",
+			expected: resource.Bloops{Name: "Rush", Task: "be quick", Points: 15, Seconds: -10, Weight: 3},
+		},
+		{text: "Flamingo; 10", err: ErrBloopsFormat},
+		{text: "; task; 10", err: ErrBloopsFormat},
+		{text: "Flamingo; task; ten", err: ErrBloopsFormat},
+		{text: "Flamingo; task; 100", err: ErrBloopsPoints},
+		{text: "Flamingo; task; 10; -30", err: ErrBloopsSeconds},
+		{text: "Flamingo; task; 10; 5; 0", err: ErrBloopsWeight},
+		{text: "Flamingo; task; 10; 5; 1; extra", err: ErrBloopsFormat},
+	}
+
+	for _, tc := range testCases {
+		got, err := parseBloops(tc.text)
+		if !errors.Is(err, tc.err) {
+			t.Errorf("parseBloops(%q): expected error %v, got %v", tc.text, tc.err, err)
+			continue
+		}
+
+		if tc.err == nil && got != tc.expected {
+			t.Errorf("parseBloops(%q): expected %+v, got %+v", tc.text, tc.expected, got)
+		}
+	}
+}
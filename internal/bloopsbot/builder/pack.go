@@ -0,0 +1,178 @@
+package builder
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	packDb "github.com/bloops-games/bloops/internal/database/pack/database"
+	packModel "github.com/bloops-games/bloops/internal/database/pack/model"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const maxPackNameLen = 32
+
+// PackStore persists custom category and letter packs
+type PackStore interface {
+	Add(pack packModel.Pack) (packModel.Pack, error)
+	Fetch(code int64) (packModel.Pack, error)
+	FetchByAuthor(authorID int64) ([]packModel.Pack, error)
+}
+
+func (bs *Session) authorPacks() ([]packModel.Pack, error) {
+	if bs.packs == nil {
+		return nil, nil
+	}
+
+	packs, err := bs.packs.FetchByAuthor(bs.AuthorID)
+	if err != nil {
+		return nil, fmt.Errorf("fetch by author: %w", err)
+	}
+
+	return packs, nil
+}
+
+// applyPack switches the locale to the pack one and replaces categories and letters with the pack ones
+func (bs *Session) applyPack(pack packModel.Pack) {
+	bs.setLocale(pack.Locale)
+	bs.PackCode = pack.Code
+
+	if len(pack.Categories) > 0 {
+		bs.Categories = make([]resource.Category, 0, len(pack.Categories))
+		for _, text := range pack.Categories {
+			bs.Categories = append(bs.Categories, resource.Category{Text: text, Status: true})
+		}
+	}
+
+	if len(pack.Letters) > 0 {
+		bs.Letters = make([]resource.Letter, 0, len(pack.Letters))
+		for _, text := range pack.Letters {
+			bs.Letters = append(bs.Letters, resource.Letter{Text: text, Status: true})
+		}
+	}
+}
+
+func (bs *Session) clickOnPacks(query *tgbotapi.CallbackQuery) error {
+	if !strings.HasPrefix(query.Data, resource.BuilderInlinePackPrefix) {
+		return fmt.Errorf("unknown pack data %s", query.Data)
+	}
+
+	code, err := strconv.ParseInt(strings.TrimPrefix(query.Data, resource.BuilderInlinePackPrefix), 10, 64)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	pack, err := bs.packs.Fetch(code)
+	if err != nil {
+		return fmt.Errorf("fetch pack: %w", err)
+	}
+
+	bs.applyPack(pack)
+	if err := bs.tg.AnswerCallback(query.ID, fmt.Sprintf(bs.lang.TextPackChosenAnswer, pack.Name)); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	bs.nextStage()
+	bs.messageCh <- struct{}{}
+
+	return nil
+}
+
+// loadSharedPack applies the pack by the share code sent by the author
+func (bs *Session) loadSharedPack(text string) error {
+	text = strings.TrimSpace(text)
+	notFoundMsg := transport.Message{ChatID: bs.ChatID, Text: fmt.Sprintf(bs.lang.TextPackNotFoundMsg, text)}
+
+	code, err := strconv.ParseInt(text, 10, 64)
+	if err != nil || bs.packs == nil {
+		if _, err := bs.tg.SendText(notFoundMsg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	pack, err := bs.packs.Fetch(code)
+	if err != nil {
+		if errors.Is(err, packDb.ErrNotFound) {
+			if _, err := bs.tg.SendText(notFoundMsg); err != nil {
+				return fmt.Errorf("send msg: %w", err)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("fetch pack: %w", err)
+	}
+
+	bs.applyPack(pack)
+	bs.nextStage()
+	bs.messageCh <- struct{}{}
+
+	return nil
+}
+
+func (bs *Session) clickOnSavePack(query *tgbotapi.CallbackQuery) error {
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.TextSavePackBtn); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	bs.awaitPackName = true
+	if _, err := bs.tg.SendText(transport.Message{ChatID: bs.ChatID, Text: bs.lang.TextSendPackNameMsg}); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// savePack stores the selected categories and letters as a new pack of the author
+func (bs *Session) savePack(name string) error {
+	name = strings.TrimSpace(name)
+	if n := utf8.RuneCountInString(name); n == 0 || n > maxPackNameLen {
+		msg := transport.Message{ChatID: bs.ChatID, Text: fmt.Sprintf(bs.lang.TextPackNameInvalidMsg, maxPackNameLen)}
+		if _, err := bs.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	pack := packModel.Pack{
+		AuthorID:  bs.AuthorID,
+		Name:      name,
+		Locale:    bs.Locale,
+		CreatedAt: time.Now(),
+	}
+
+	for _, category := range bs.Categories {
+		if category.Status {
+			pack.Categories = append(pack.Categories, category.Text)
+		}
+	}
+
+	for _, letter := range bs.Letters {
+		if letter.Status {
+			pack.Letters = append(pack.Letters, letter.Text)
+		}
+	}
+
+	pack, err := bs.packs.Add(pack)
+	if err != nil {
+		return fmt.Errorf("add pack: %w", err)
+	}
+
+	bs.awaitPackName = false
+	bs.PackCode = pack.Code
+
+	msg := transport.Message{ChatID: bs.ChatID, Text: fmt.Sprintf(bs.lang.TextPackSavedMsg, pack.Name, pack.Code)}
+	if _, err := bs.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
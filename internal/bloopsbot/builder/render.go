@@ -5,6 +5,7 @@ import (
 	"strconv"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	packModel "github.com/bloops-games/bloops/internal/database/pack/model"
 	"github.com/enescakir/emoji"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 )
@@ -14,20 +15,235 @@ const (
 	maxLargeCellsRow = 3
 )
 
+func (bs *Session) renderInlineLocales() tgbotapi.InlineKeyboardMarkup {
+	row := tgbotapi.NewInlineKeyboardRow()
+	for _, lang := range resource.Locales() {
+		if lang.Locale == bs.Locale {
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(emoji.CheckMarkButton.String()+" "+lang.Name, lang.Locale))
+		} else {
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(lang.Name, lang.Locale))
+		}
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(row)
+}
+
+func (bs *Session) renderInlinePacks(packs []packModel.Pack) tgbotapi.InlineKeyboardMarkup {
+	var btn tgbotapi.InlineKeyboardButton
+	markup := tgbotapi.NewInlineKeyboardMarkup()
+	for _, pack := range packs {
+		text := fmt.Sprintf("%s · %d", pack.Name, pack.Code)
+		data := resource.BuilderInlinePackPrefix + strconv.FormatInt(pack.Code, 10)
+		if pack.Code == bs.PackCode {
+			btn = tgbotapi.NewInlineKeyboardButtonData(emoji.CheckMarkButton.String()+" "+text, data)
+		} else {
+			btn = tgbotapi.NewInlineKeyboardButtonData(text, data)
+		}
+
+		markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(btn))
+	}
+
+	return markup
+}
+
+func (bs *Session) renderInlineDone() tgbotapi.InlineKeyboardMarkup {
+	markup := tgbotapi.NewInlineKeyboardMarkup()
+	if bs.packs != nil {
+		markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextSavePackBtn, resource.BuilderInlineSavePackData),
+		))
+	}
+
+	return markup
+}
+
 func (bs *Session) renderInlineBloops() tgbotapi.InlineKeyboardMarkup {
 	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData(resource.TextVoteYes, "true"),
-		tgbotapi.NewInlineKeyboardButtonData(resource.TextVoteNo, "false"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteYes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteNo, "false"),
+	))
+}
+
+func (bs *Session) renderInlineBloopses() tgbotapi.InlineKeyboardMarkup {
+	var btn tgbotapi.InlineKeyboardButton
+	markup := tgbotapi.NewInlineKeyboardMarkup()
+	row := tgbotapi.NewInlineKeyboardRow()
+	for i, bloops := range bs.Bloopses {
+		if len(row) == maxLargeCellsRow {
+			markup.InlineKeyboard = append(markup.InlineKeyboard, row)
+			row = tgbotapi.NewInlineKeyboardRow()
+		}
+
+		if bloops.Status {
+			btn = tgbotapi.NewInlineKeyboardButtonData(emoji.CheckMarkButton.String()+" "+bloops.Name, strconv.Itoa(i))
+		} else {
+			btn = tgbotapi.NewInlineKeyboardButtonData(emoji.CrossMark.String()+" "+bloops.Name, strconv.Itoa(i))
+		}
+
+		row = append(row, btn)
+	}
+
+	if len(row) > 0 {
+		markup.InlineKeyboard = append(markup.InlineKeyboard, row)
+	}
+
+	return markup
+}
+
+func (bs *Session) renderInlineBloopsChance() tgbotapi.InlineKeyboardMarkup {
+	row := tgbotapi.NewInlineKeyboardRow()
+	for _, chance := range resource.BloopsChances {
+		text := fmt.Sprintf("%d%%", chance)
+		if chance == bs.BloopsChance {
+			text = emoji.CheckMarkButton.String() + " " + text
+		}
+
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(text, strconv.Itoa(chance)))
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(
+		row,
+		tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData(bs.bloopsRepeatText(), bloopsRepeatData)),
+	)
+}
+
+func (bs *Session) renderInlineAnswers() tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteYes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteNo, "false"),
 	))
 }
 
 func (bs *Session) renderInlineVote() tgbotapi.InlineKeyboardMarkup {
 	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
-		tgbotapi.NewInlineKeyboardButtonData(resource.TextVoteYes, "true"),
-		tgbotapi.NewInlineKeyboardButtonData(resource.TextVoteNo, "false"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteYes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteNo, "false"),
+	))
+}
+
+func (bs *Session) renderInlineSpectators() tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteYes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteNo, "false"),
+	))
+}
+
+func (bs *Session) renderInlineOfflineVote() tgbotapi.InlineKeyboardMarkup {
+	return tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteYes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(bs.lang.TextVoteNo, "false"),
 	))
 }
 
+func (bs *Session) renderInlinePrivate() tgbotapi.InlineKeyboardMarkup {
+	yes, no := bs.lang.TextVoteYes, bs.lang.TextVoteNo
+	if bs.Private {
+		yes = emoji.CheckMarkButton.String() + " " + yes
+	} else {
+		no = emoji.CheckMarkButton.String() + " " + no
+	}
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(yes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(no, "false"),
+	))
+	if bs.PIN != "" {
+		markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				emoji.CrossMark.String()+" "+fmt.Sprintf(bs.lang.TextPIN, bs.PIN),
+				privatePINDeleteData,
+			),
+		))
+	}
+
+	return markup
+}
+
+func (bs *Session) renderInlinePublic() tgbotapi.InlineKeyboardMarkup {
+	yes, no := bs.lang.TextVoteYes, bs.lang.TextVoteNo
+	if bs.Public {
+		yes = emoji.CheckMarkButton.String() + " " + yes
+	} else {
+		no = emoji.CheckMarkButton.String() + " " + no
+	}
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(yes, "true"),
+		tgbotapi.NewInlineKeyboardButtonData(no, "false"),
+	))
+	if !bs.Public {
+		return markup
+	}
+
+	row := tgbotapi.NewInlineKeyboardRow()
+	for _, n := range resource.MaxPlayersNums {
+		text := fmt.Sprintf(bs.lang.TextMaxPlayers, n)
+		if n == bs.MaxPlayers {
+			text = emoji.CheckMarkButton.String() + " " + text
+		}
+
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(text, publicMaxPlayersPrefix+strconv.Itoa(n)))
+	}
+
+	markup.InlineKeyboard = append(markup.InlineKeyboard, row)
+
+	return markup
+}
+
+func (bs *Session) renderInlineTeams() tgbotapi.InlineKeyboardMarkup {
+	text := bs.lang.TextNoTeams
+	if len(bs.Teams) == 0 {
+		text = emoji.CheckMarkButton.String() + " " + text
+	}
+
+	row := tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData(text, teamsNumPrefix+"0"))
+	for _, n := range resource.TeamsNums {
+		text := strconv.Itoa(n)
+		if n == len(bs.Teams) {
+			text = emoji.CheckMarkButton.String() + " " + text
+		}
+
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(text, teamsNumPrefix+strconv.Itoa(n)))
+	}
+
+	markup := tgbotapi.NewInlineKeyboardMarkup(row)
+	for i, team := range bs.Teams {
+		markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(emoji.CrossMark.String()+" "+team, teamsDeletePrefix+strconv.Itoa(i)),
+		))
+	}
+
+	return markup
+}
+
+func (bs *Session) renderInlineVoteRule() tgbotapi.InlineKeyboardMarkup {
+	markup := tgbotapi.NewInlineKeyboardMarkup()
+	for _, rule := range resource.VoteRules {
+		text := bs.lang.VoteRuleText(rule)
+		if rule == bs.VoteRule {
+			text = emoji.CheckMarkButton.String() + " " + text
+		}
+
+		markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(text, voteRulePrefix+strconv.Itoa(int(rule))),
+		))
+	}
+
+	row := tgbotapi.NewInlineKeyboardRow()
+	for _, timeout := range resource.VoteTimeouts {
+		text := fmt.Sprintf("%d %s", timeout, bs.lang.Plural(timeout, bs.lang.NounSeconds))
+		if timeout == bs.VoteTimeout {
+			text = emoji.CheckMarkButton.String() + " " + text
+		}
+
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(text, voteTimeoutPrefix+strconv.Itoa(timeout)))
+	}
+
+	markup.InlineKeyboard = append(markup.InlineKeyboard, row)
+
+	return markup
+}
+
 func (bs *Session) renderInlineLetters() tgbotapi.InlineKeyboardMarkup {
 	var btn tgbotapi.InlineKeyboardButton
 	markup := tgbotapi.NewInlineKeyboardMarkup()
@@ -132,13 +348,13 @@ func (bs *Session) menuInlineButtons(markup tgbotapi.InlineKeyboardMarkup) tgbot
 	row := tgbotapi.NewInlineKeyboardRow()
 
 	if !bs.state.isMin() {
-		row = append(row, tgbotapi.NewInlineKeyboardButtonData(resource.BuilderInlinePrevText, resource.BuilderInlinePrevData))
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(bs.lang.BuilderInlinePrevText, resource.BuilderInlinePrevData))
 	}
 
 	if !bs.state.isMax() {
-		row = append(row, tgbotapi.NewInlineKeyboardButtonData(resource.BuilderInlineNextText, resource.BuilderInlineNextData))
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(bs.lang.BuilderInlineNextText, resource.BuilderInlineNextData))
 	} else {
-		row = append(row, tgbotapi.NewInlineKeyboardButtonData(resource.BuilderInlineDoneText, resource.BuilderInlineDoneData))
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(bs.lang.BuilderInlineDoneText, resource.BuilderInlineDoneData))
 	}
 
 	markup.InlineKeyboard = append(markup.InlineKeyboard, row)
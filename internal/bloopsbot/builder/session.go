@@ -8,70 +8,105 @@ import (
 	"time"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	bloopsModel "github.com/bloops-games/bloops/internal/database/bloops/model"
 	"github.com/bloops-games/bloops/internal/logging"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 )
 
-const (
-	defaultRoundsNum = 1
-	minCategoriesNum = 3
-	defaultRoundTime = 30
-)
+const minCategoriesNum = 3
 
 type QueryCallbackHandlerFunc func(query *tgbotapi.CallbackQuery) error
 
 type stateKind uint8
 
 const (
-	stateKindCategories stateKind = iota + 1
+	stateKindLocale stateKind = iota + 1
+	stateKindPacks
+	stateKindCategories
 	stateKindRoundsNum
 	stateKindLetters
 	stateKindBloops
+	stateKindBloopses
+	stateKindBloopsChance
+	stateKindAnswers
 	stateKindVote
+	stateKindVoteRule
+	stateKindSpectators
+	stateKindOfflineVote
+	stateKindTeams
+	stateKindPrivate
+	stateKindPublic
 	stateKindDone
 )
 
 var stages = []stateKind{
+	stateKindLocale,
+	stateKindPacks,
 	stateKindCategories,
 	stateKindRoundsNum,
 	stateKindLetters,
 	stateKindBloops,
+	stateKindBloopses,
+	stateKindBloopsChance,
+	stateKindAnswers,
 	stateKindVote,
+	stateKindVoteRule,
+	stateKindSpectators,
+	stateKindOfflineVote,
+	stateKindTeams,
+	stateKindPrivate,
+	stateKindPublic,
 	stateKindDone,
 }
 
 func NewSession(
-	tg *tgbotapi.BotAPI,
+	tg transport.Transport,
 	chatID int64,
 	authorID int64,
 	authorName string,
+	locale string,
+	packs PackStore,
+	bloopses BloopsStore,
 	doneFn func(session *Session) error,
 	warnFn func(session *Session) error,
+	expireFn func(session *Session) error,
 	timeout time.Duration,
 ) (*Session, error) {
 	state := newStateMachine(stages...)
 	s := &Session{
 		tg:              tg,
+		packs:           packs,
+		bloopses:        bloopses,
 		state:           state,
 		messageCh:       make(chan struct{}, 1),
 		ChatID:          chatID,
 		AuthorID:        authorID,
 		AuthorName:      authorName,
-		RoundsNum:       defaultRoundsNum,
-		RoundTime:       defaultRoundTime,
+		RoundsNum:       resource.DefaultRoundsNum,
+		RoundTime:       resource.DefaultRoundTime,
+		BloopsChance:    resource.DefaultBloopsChance,
+		VoteRule:        resource.DefaultVoteRule,
+		VoteTimeout:     resource.DefaultVoteTimeout,
 		timeout:         timeout,
 		doneFn:          doneFn,
 		warnFn:          warnFn,
+		expireFn:        expireFn,
 		controlHandlers: map[string]QueryCallbackHandlerFunc{},
 		actionHandlers:  map[stateKind]QueryCallbackHandlerFunc{},
 		CreatedAt:       time.Now(),
 	}
 
-	s.Categories = make([]resource.Category, len(resource.Categories))
-	copy(s.Categories, resource.Categories)
+	if bloopses != nil {
+		custom, err := bloopses.FetchByAuthor(authorID)
+		if err != nil {
+			return nil, fmt.Errorf("fetch custom bloopses: %w", err)
+		}
 
-	s.Letters = make([]resource.Letter, len(resource.Letters))
-	copy(s.Letters, resource.Letters)
+		s.customBloopses = custom
+	}
+
+	s.setLocale(locale)
 
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
@@ -79,12 +114,24 @@ func NewSession(
 	s.handleControlCb(resource.BuilderInlineNextData, s.clickOnNext)
 	s.handleControlCb(resource.BuilderInlinePrevData, s.clickOnPrev)
 	s.handleControlCb(resource.BuilderInlineDoneData, s.clickOnDone)
+	s.handleControlCb(resource.BuilderInlineSavePackData, s.clickOnSavePack)
 
+	s.handleActionCb(stateKindLocale, s.clickOnLocale)
+	s.handleActionCb(stateKindPacks, s.clickOnPacks)
 	s.handleActionCb(stateKindCategories, s.clickOnCategories)
 	s.handleActionCb(stateKindRoundsNum, s.clickOnRoundsNum)
 	s.handleActionCb(stateKindLetters, s.clickOnLetters)
 	s.handleActionCb(stateKindBloops, s.clickOnBloops)
+	s.handleActionCb(stateKindBloopses, s.clickOnBloopses)
+	s.handleActionCb(stateKindBloopsChance, s.clickOnBloopsChance)
+	s.handleActionCb(stateKindAnswers, s.clickOnAnswers)
 	s.handleActionCb(stateKindVote, s.clickOnVote)
+	s.handleActionCb(stateKindVoteRule, s.clickOnVoteRule)
+	s.handleActionCb(stateKindSpectators, s.clickOnSpectators)
+	s.handleActionCb(stateKindOfflineVote, s.clickOnOfflineVote)
+	s.handleActionCb(stateKindTeams, s.clickOnTeams)
+	s.handleActionCb(stateKindPrivate, s.clickOnPrivate)
+	s.handleActionCb(stateKindPublic, s.clickOnPublic)
 
 	return s, nil
 }
@@ -98,15 +145,51 @@ type Session struct {
 	RoundTime  int
 	Vote       bool
 	Bloops     bool
-	ChatID     int64
-	CreatedAt  time.Time
-
-	tg        *tgbotapi.BotAPI
+	Bloopses   []BloopsOption
+	// chance in percent that a bloops drops before a turn
+	BloopsChance int
+	BloopsRepeat bool
+	Locale       string
+	ChatID       int64
+	// how the ballots change the points of the round
+	VoteRule resource.VoteRule
+	// time in seconds for the players to vote
+	VoteTimeout int
+	// the player types the answers in the chat
+	TypedAnswers bool
+	// the spectators vote along with the players
+	SpectatorsVote bool
+	// the offline players vote through their host
+	OfflineVote bool
+	// names of the teams, the players play for themselves if empty
+	Teams []string
+	// the author approves the join requests
+	Private bool
+	// the players type the PIN before the join request of the private game is sent to the author
+	PIN string
+	// the game is listed in the public games
+	Public bool
+	// most players of the public game
+	MaxPlayers int
+	// code of the pack the categories and letters were taken from or saved to
+	PackCode int64
+	// the game is built in a group chat and will be played there
+	Group     bool
+	CreatedAt time.Time
+
+	tg        transport.Transport
+	lang      *resource.Catalog
+	packs     PackStore
+	bloopses  BloopsStore
 	state     *stateMachine
 	messageCh chan struct{}
 	sema      sync.Once
 
 	messageID int
+	// the next text message is the name of the pack to save
+	awaitPackName bool
+	// custom bloopses of the author in all locales
+	customBloopses []bloopsModel.Bloops
 
 	timeout time.Duration
 
@@ -117,10 +200,13 @@ type Session struct {
 	cancel func()
 	doneFn func(session *Session) error
 	warnFn func(session *Session) error
+	// expireFn is called when the setting has run out of the timeout
+	expireFn func(session *Session) error
 }
 
 func (bs *Session) Run(ctx context.Context) {
-	ctx, cancel := context.WithTimeout(ctx, bs.timeout)
+	// the restored setting keeps the time it has already taken
+	ctx, cancel := context.WithTimeout(ctx, bs.timeout-time.Since(bs.CreatedAt))
 	bs.cancel = cancel
 	logger := logging.FromContext(ctx)
 	bs.sema.Do(func() {
@@ -131,6 +217,16 @@ func (bs *Session) Run(ctx context.Context) {
 	logger.Infof("Building session has started, author: %s", bs.AuthorName)
 }
 
+// Stage returns the current setting step, it is used to resume the setting after a restart
+func (bs *Session) Stage() uint8 {
+	return uint8(bs.state.curr())
+}
+
+// SeekStage moves the setting to the step, must be called before Run
+func (bs *Session) SeekStage(stage uint8) {
+	bs.state.seek(stateKind(stage))
+}
+
 func (bs *Session) Stop() {
 	defer close(bs.messageCh)
 	bs.cancel()
@@ -156,14 +252,36 @@ func (bs *Session) Execute(upd tgbotapi.Update) error {
 }
 
 func (bs *Session) executeMessageQuery(query *tgbotapi.Message) error {
-	if bs.state.curr() == stateKindCategories {
+	switch bs.state.curr() {
+	case stateKindPacks:
+		if err := bs.loadSharedPack(query.Text); err != nil {
+			return fmt.Errorf("load shared pack: %w", err)
+		}
+	case stateKindBloopses:
+		if err := bs.addCustomBloops(query.Text); err != nil {
+			return fmt.Errorf("add custom bloops: %w", err)
+		}
+	case stateKindTeams:
+		if err := bs.addTeam(query.Text); err != nil {
+			return fmt.Errorf("add team: %w", err)
+		}
+	case stateKindPrivate:
+		if err := bs.setPIN(query.Text); err != nil {
+			return fmt.Errorf("set pin: %w", err)
+		}
+	case stateKindDone:
+		if bs.awaitPackName {
+			if err := bs.savePack(query.Text); err != nil {
+				return fmt.Errorf("save pack: %w", err)
+			}
+		}
+	case stateKindCategories:
 		bs.Categories = append(bs.Categories, resource.Category{
 			Text:   query.Text,
 			Status: true,
 		})
 
-		msg := tgbotapi.NewEditMessageReplyMarkup(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineCategories()))
-		if _, err := bs.tg.Send(msg); err != nil {
+		if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineCategories())); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 	}
@@ -225,60 +343,197 @@ func (bs *Session) loop(ctx context.Context) {
 			return
 		case <-bs.messageCh:
 			switch bs.state.curr() {
+			case stateKindLocale:
+				logger.Infof("Building session, sending locales, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChooseLocale,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineLocales()),
+				})
+				if err != nil {
+					logger.Errorf("send locales: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindPacks:
+				logger.Infof("Building session, sending packs, author %s", bs.AuthorName)
+				packs, err := bs.authorPacks()
+				if err != nil {
+					logger.Errorf("fetch packs: %v", err)
+				}
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChoosePack,
+					Keyboard: bs.menuInlineButtons(bs.renderInlinePacks(packs)),
+				})
+				if err != nil {
+					logger.Errorf("send packs: %v", err)
+				}
+				bs.messageID = messageID
 			case stateKindCategories:
 				logger.Infof("Building session, sending categories, author %s", bs.AuthorName)
-				msg := tgbotapi.NewMessage(bs.ChatID, resource.TextChooseCategories)
-				msg.ReplyMarkup = bs.menuInlineButtons(bs.renderInlineCategories())
-				output, err := bs.tg.Send(msg)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChooseCategories,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineCategories()),
+				})
 				if err != nil {
 					logger.Errorf("send categories: %v", err)
 				}
-				bs.messageID = output.MessageID
+				bs.messageID = messageID
 			case stateKindRoundsNum:
 				logger.Infof("Building session, sending rounds number, author %s", bs.AuthorName)
-				msg := tgbotapi.NewMessage(bs.ChatID, resource.TextChooseRoundsNum)
-				msg.ReplyMarkup = bs.menuInlineButtons(bs.renderRoundsNum())
-				output, err := bs.tg.Send(msg)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChooseRoundsNum,
+					Keyboard: bs.menuInlineButtons(bs.renderRoundsNum()),
+				})
 				if err != nil {
 					logger.Errorf("send round num: %v", err)
 				}
-				bs.messageID = output.MessageID
+				bs.messageID = messageID
 			case stateKindLetters:
 				logger.Infof("Building session, sending letters, author %s", bs.AuthorName)
-				msg := tgbotapi.NewMessage(bs.ChatID, resource.TextDeleteComplexLetters)
-				msg.ReplyMarkup = bs.menuInlineButtons(bs.renderInlineLetters())
-				output, err := bs.tg.Send(msg)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextDeleteComplexLetters,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineLetters()),
+				})
 				if err != nil {
 					logger.Errorf("send letters: %v", err)
 				}
-				bs.messageID = output.MessageID
+				bs.messageID = messageID
 			case stateKindBloops:
 				logger.Infof("Building session, sending bloopses, author %s", bs.AuthorName)
-				msg := tgbotapi.NewMessage(bs.ChatID, resource.TextBloopsAllowed)
-				msg.ReplyMarkup = bs.menuInlineButtons(bs.renderInlineBloops())
-				output, err := bs.tg.Send(msg)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextBloopsAllowed,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineBloops()),
+				})
 				if err != nil {
 					logger.Errorf("send letters: %v", err)
 				}
-				bs.messageID = output.MessageID
+				bs.messageID = messageID
+			case stateKindBloopses:
+				logger.Infof("Building session, sending bloopses list, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.chooseBloopsesText(),
+					Keyboard: bs.menuInlineButtons(bs.renderInlineBloopses()),
+				})
+				if err != nil {
+					logger.Errorf("send bloopses: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindBloopsChance:
+				logger.Infof("Building session, sending bloops chance, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChooseBloopsChance,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineBloopsChance()),
+				})
+				if err != nil {
+					logger.Errorf("send bloops chance: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindAnswers:
+				logger.Infof("Building session, sending typed answers, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     fmt.Sprintf(bs.lang.TextTypedAnswersAllowed, resource.AnswerPoints),
+					Keyboard: bs.menuInlineButtons(bs.renderInlineAnswers()),
+				})
+				if err != nil {
+					logger.Errorf("send typed answers: %v", err)
+				}
+				bs.messageID = messageID
 			case stateKindVote:
 				logger.Infof("Building session, sending vote, author %s", bs.AuthorName)
-				msg := tgbotapi.NewMessage(bs.ChatID, resource.TextVoteAllowed)
-				msg.ReplyMarkup = bs.menuInlineButtons(bs.renderInlineVote())
-				output, err := bs.tg.Send(msg)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextVoteAllowed,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineVote()),
+				})
 				if err != nil {
 					logger.Errorf("send vote: %v", err)
 				}
-				bs.messageID = output.MessageID
+				bs.messageID = messageID
+			case stateKindVoteRule:
+				logger.Infof("Building session, sending vote rule, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChooseVoteRule,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineVoteRule()),
+				})
+				if err != nil {
+					logger.Errorf("send vote rule: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindSpectators:
+				logger.Infof("Building session, sending spectators vote, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextSpectatorsVoteAllowed,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineSpectators()),
+				})
+				if err != nil {
+					logger.Errorf("send spectators vote: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindOfflineVote:
+				logger.Infof("Building session, sending offline players vote, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextOfflineVoteAllowed,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineOfflineVote()),
+				})
+				if err != nil {
+					logger.Errorf("send offline players vote: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindTeams:
+				logger.Infof("Building session, sending teams, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChooseTeams,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineTeams()),
+				})
+				if err != nil {
+					logger.Errorf("send teams: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindPrivate:
+				logger.Infof("Building session, sending private game, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChoosePrivate,
+					Keyboard: bs.menuInlineButtons(bs.renderInlinePrivate()),
+				})
+				if err != nil {
+					logger.Errorf("send private game: %v", err)
+				}
+				bs.messageID = messageID
+			case stateKindPublic:
+				logger.Infof("Building session, sending public game, author %s", bs.AuthorName)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextChoosePublic,
+					Keyboard: bs.menuInlineButtons(bs.renderInlinePublic()),
+				})
+				if err != nil {
+					logger.Errorf("send public game: %v", err)
+				}
+				bs.messageID = messageID
 			case stateKindDone:
 				logger.Infof("Building session, sending done action, author %s", bs.AuthorName)
-				msg := tgbotapi.NewMessage(bs.ChatID, resource.TextConfigurationDone)
-				msg.ReplyMarkup = bs.menuInlineButtons(tgbotapi.NewInlineKeyboardMarkup())
-				output, err := bs.tg.Send(msg)
+				messageID, err := bs.tg.SendText(transport.Message{
+					ChatID:   bs.ChatID,
+					Text:     bs.lang.TextConfigurationDone,
+					Keyboard: bs.menuInlineButtons(bs.renderInlineDone()),
+				})
 				if err != nil {
 					logger.Errorf("send done: %v", err)
 				}
-				bs.messageID = output.MessageID
+				bs.messageID = messageID
 			}
 		}
 	}
@@ -288,7 +543,7 @@ func (bs *Session) shutdown(ctx context.Context) bool {
 	logger := logging.FromContext(ctx)
 	if time.Since(bs.CreatedAt) <= bs.timeout {
 		if bs.state.state != stateKindDone {
-			if _, err := bs.tg.Send(tgbotapi.NewMessage(bs.AuthorID, resource.TextBuilderWarnMsg)); err != nil {
+			if _, err := bs.tg.SendText(transport.Message{ChatID: bs.AuthorID, Text: bs.lang.TextBuilderWarnMsg}); err != nil {
 				logger.Errorf("send msg: %v", err)
 			}
 
@@ -301,14 +556,16 @@ func (bs *Session) shutdown(ctx context.Context) bool {
 		if err := bs.doneFn(bs); err != nil {
 			logger.Errorf("done function: %v", err)
 		}
+	} else if err := bs.expireFn(bs); err != nil {
+		logger.Errorf("expire function: %v", err)
 	}
 	logger.Infof("Building session is complete, author: %s", bs.AuthorName)
 	return false
 }
 
 func (bs *Session) clickOnPrev(query *tgbotapi.CallbackQuery) error {
-	bs.state.prev()
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.BuilderInlinePrevText)); err != nil {
+	bs.prevStage()
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlinePrevText); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 	bs.messageCh <- struct{}{}
@@ -317,8 +574,8 @@ func (bs *Session) clickOnPrev(query *tgbotapi.CallbackQuery) error {
 }
 
 func (bs *Session) clickOnNext(query *tgbotapi.CallbackQuery) error {
-	bs.state.next()
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.BuilderInlineNextText)); err != nil {
+	bs.nextStage()
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 	bs.messageCh <- struct{}{}
@@ -327,13 +584,13 @@ func (bs *Session) clickOnNext(query *tgbotapi.CallbackQuery) error {
 }
 
 func (bs *Session) clickOnDone(query *tgbotapi.CallbackQuery) error {
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.BuilderInlineDoneText)); err != nil {
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineDoneText); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 
 	if bs.numCategoriesIncluded() < minCategoriesNum {
-		msg := tgbotapi.NewMessage(bs.ChatID, resource.TextAddLeastCategoryToComplete)
-		if _, err := bs.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: bs.ChatID, Text: bs.lang.TextAddLeastCategoryToComplete}
+		if _, err := bs.tg.SendText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -341,8 +598,8 @@ func (bs *Session) clickOnDone(query *tgbotapi.CallbackQuery) error {
 	}
 
 	if !bs.lettersExist() {
-		msg := tgbotapi.NewMessage(bs.ChatID, resource.TextAddLeastOneLetterToComplete)
-		if _, err := bs.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: bs.ChatID, Text: bs.lang.TextAddLeastOneLetterToComplete}
+		if _, err := bs.tg.SendText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -354,25 +611,51 @@ func (bs *Session) clickOnDone(query *tgbotapi.CallbackQuery) error {
 	return nil
 }
 
+// setLocale switches the texts of the builder and resets categories and letters to the ones of the locale
+func (bs *Session) setLocale(locale string) {
+	bs.lang = resource.Lang(locale)
+	bs.Locale = bs.lang.Locale
+	bs.PackCode = 0
+
+	bs.Categories = make([]resource.Category, len(bs.lang.Categories))
+	copy(bs.Categories, bs.lang.Categories)
+
+	bs.Letters = make([]resource.Letter, len(bs.lang.Letters))
+	copy(bs.Letters, bs.lang.Letters)
+
+	bs.setBloopses()
+}
+
+func (bs *Session) clickOnLocale(query *tgbotapi.CallbackQuery) error {
+	bs.setLocale(query.Data)
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.Name); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	bs.nextStage()
+	bs.messageCh <- struct{}{}
+
+	return nil
+}
+
 func (bs *Session) clickOnCategories(query *tgbotapi.CallbackQuery) error {
 	var answer string
 	for i, category := range bs.Categories {
 		if query.Data == category.Text {
 			bs.Categories[i].Status = !category.Status
 			if bs.Categories[i].Status {
-				answer = fmt.Sprintf(resource.TextAddedCategory, category.Text)
+				answer = fmt.Sprintf(bs.lang.TextAddedCategory, category.Text)
 			} else {
-				answer = fmt.Sprintf(resource.TextDeletedCategory, category.Text)
+				answer = fmt.Sprintf(bs.lang.TextDeletedCategory, category.Text)
 			}
 		}
 	}
 
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, answer)); err != nil {
+	if err := bs.tg.AnswerCallback(query.ID, answer); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 
-	msg := tgbotapi.NewEditMessageReplyMarkup(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineCategories()))
-	if _, err := bs.tg.Send(msg); err != nil {
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineCategories())); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -385,12 +668,12 @@ func (bs *Session) clickOnRoundsNum(query *tgbotapi.CallbackQuery) error {
 		return fmt.Errorf("strconv: %w", err)
 	}
 
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, fmt.Sprintf(resource.TextRoundsNumAnswer, n))); err != nil {
+	if err := bs.tg.AnswerCallback(query.ID, fmt.Sprintf(bs.lang.TextRoundsNumAnswer, n)); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 
 	bs.RoundsNum = n
-	bs.state.next()
+	bs.nextStage()
 	bs.messageCh <- struct{}{}
 
 	return nil
@@ -402,19 +685,18 @@ func (bs *Session) clickOnLetters(query *tgbotapi.CallbackQuery) error {
 		if query.Data == letter.Text {
 			bs.Letters[i].Status = !letter.Status
 			if bs.Letters[i].Status {
-				answer = fmt.Sprintf(resource.TextAddedLetter, letter.Text)
+				answer = fmt.Sprintf(bs.lang.TextAddedLetter, letter.Text)
 			} else {
-				answer = fmt.Sprintf(resource.TextDeletedLetter, letter.Text)
+				answer = fmt.Sprintf(bs.lang.TextDeletedLetter, letter.Text)
 			}
 		}
 	}
 
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, answer)); err != nil {
+	if err := bs.tg.AnswerCallback(query.ID, answer); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 
-	msg := tgbotapi.NewEditMessageReplyMarkup(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineLetters()))
-	if _, err := bs.tg.Send(msg); err != nil {
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineLetters())); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -427,12 +709,29 @@ func (bs *Session) clickOnBloops(query *tgbotapi.CallbackQuery) error {
 		return fmt.Errorf("strconv: %w", err)
 	}
 
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.BuilderInlineNextText)); err != nil {
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 
 	bs.Bloops = value
-	bs.state.next()
+	bs.nextStage()
+	bs.messageCh <- struct{}{}
+
+	return nil
+}
+
+func (bs *Session) clickOnAnswers(query *tgbotapi.CallbackQuery) error {
+	value, err := strconv.ParseBool(query.Data)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	bs.TypedAnswers = value
+	bs.nextStage()
 	bs.messageCh <- struct{}{}
 
 	return nil
@@ -444,17 +743,75 @@ func (bs *Session) clickOnVote(query *tgbotapi.CallbackQuery) error {
 		return fmt.Errorf("strconv: %w", err)
 	}
 
-	if _, err := bs.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.BuilderInlineNextText)); err != nil {
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
 		return fmt.Errorf("send answer msg: %w", err)
 	}
 
 	bs.Vote = value
-	bs.state.next()
+	bs.nextStage()
+	bs.messageCh <- struct{}{}
+
+	return nil
+}
+
+func (bs *Session) clickOnSpectators(query *tgbotapi.CallbackQuery) error {
+	value, err := strconv.ParseBool(query.Data)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	bs.SpectatorsVote = value
+	bs.nextStage()
+	bs.messageCh <- struct{}{}
+
+	return nil
+}
+
+func (bs *Session) clickOnOfflineVote(query *tgbotapi.CallbackQuery) error {
+	value, err := strconv.ParseBool(query.Data)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	bs.OfflineVote = value
+	bs.nextStage()
 	bs.messageCh <- struct{}{}
 
 	return nil
 }
 
+// skipped reports whether the stage is irrelevant for the current settings
+func (bs *Session) skipped(kind stateKind) bool {
+	return (kind == stateKindBloopses || kind == stateKindBloopsChance) && !bs.Bloops ||
+		(kind == stateKindVoteRule || kind == stateKindSpectators || kind == stateKindOfflineVote) && !bs.Vote ||
+		kind == stateKindPrivate && bs.Group ||
+		kind == stateKindPublic && (bs.Group || bs.Private)
+}
+
+func (bs *Session) nextStage() {
+	for bs.state.next() {
+		if !bs.skipped(bs.state.curr()) {
+			return
+		}
+	}
+}
+
+func (bs *Session) prevStage() {
+	for bs.state.prev() {
+		if !bs.skipped(bs.state.curr()) {
+			return
+		}
+	}
+}
+
 func (bs *Session) lettersExist() bool {
 	for _, letter := range bs.Letters {
 		if letter.Status {
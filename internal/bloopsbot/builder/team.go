@@ -0,0 +1,81 @@
+package builder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	teamsNumPrefix    = "num:"
+	teamsDeletePrefix = "del:"
+)
+
+func (bs *Session) clickOnTeams(query *tgbotapi.CallbackQuery) error {
+	switch {
+	case strings.HasPrefix(query.Data, teamsNumPrefix):
+		n, err := strconv.Atoi(strings.TrimPrefix(query.Data, teamsNumPrefix))
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.setTeamsNum(n)
+	case strings.HasPrefix(query.Data, teamsDeletePrefix):
+		idx, err := strconv.Atoi(strings.TrimPrefix(query.Data, teamsDeletePrefix))
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		if idx >= 0 && idx < len(bs.Teams) {
+			bs.Teams = append(bs.Teams[:idx], bs.Teams[idx+1:]...)
+		}
+	default:
+		return fmt.Errorf("unknown teams data %s", query.Data)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, bs.lang.BuilderInlineNextText); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineTeams())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// setTeamsNum keeps the names of the first n teams and names the rest by their numbers
+func (bs *Session) setTeamsNum(n int) {
+	if n < len(bs.Teams) {
+		bs.Teams = bs.Teams[:n]
+		return
+	}
+
+	for i := len(bs.Teams); i < n; i++ {
+		bs.Teams = append(bs.Teams, fmt.Sprintf(bs.lang.TextTeamName, i+1))
+	}
+}
+
+// addTeam adds the team typed by the author
+func (bs *Session) addTeam(name string) error {
+	name = strings.TrimSpace(name)
+	if name == "" || len(bs.Teams) >= resource.MaxTeams {
+		return nil
+	}
+
+	for _, team := range bs.Teams {
+		if team == name {
+			return nil
+		}
+	}
+
+	bs.Teams = append(bs.Teams, name)
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineTeams())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
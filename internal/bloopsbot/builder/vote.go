@@ -0,0 +1,49 @@
+package builder
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	voteRulePrefix    = "rule:"
+	voteTimeoutPrefix = "timeout:"
+)
+
+func (bs *Session) clickOnVoteRule(query *tgbotapi.CallbackQuery) error {
+	var answer string
+	switch {
+	case strings.HasPrefix(query.Data, voteRulePrefix):
+		rule, err := strconv.Atoi(strings.TrimPrefix(query.Data, voteRulePrefix))
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.VoteRule = resource.VoteRule(rule)
+		answer = bs.lang.VoteRuleText(bs.VoteRule)
+	case strings.HasPrefix(query.Data, voteTimeoutPrefix):
+		timeout, err := strconv.Atoi(strings.TrimPrefix(query.Data, voteTimeoutPrefix))
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		bs.VoteTimeout = timeout
+		answer = fmt.Sprintf(bs.lang.TextVoteTimeoutAnswer, timeout)
+	default:
+		return fmt.Errorf("unknown vote rule data %s", query.Data)
+	}
+
+	if err := bs.tg.AnswerCallback(query.ID, answer); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if err := bs.tg.EditKeyboard(bs.ChatID, bs.messageID, bs.menuInlineButtons(bs.renderInlineVoteRule())); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
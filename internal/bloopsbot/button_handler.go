@@ -3,21 +3,20 @@ package bloopsbot
 import (
 	"errors"
 	"fmt"
-	"strconv"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/builder"
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
-	matchstateModel "github.com/bloops-games/bloops/internal/database/matchstate/model"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	statDb "github.com/bloops-games/bloops/internal/database/stat/database"
 	userModel "github.com/bloops-games/bloops/internal/database/user/model"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 )
 
-func (m *manager) handleRulesButton(_ userModel.User, chatID int64) error {
-	msgText := resource.TextRulesMsg
-	msg := tgbotapi.NewMessage(chatID, msgText)
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	if _, err := m.tg.Send(msg); err != nil {
+func (m *manager) handleRulesButton(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
+	msgText := lang.TextRulesMsg
+	msg := transport.Message{ChatID: chatID, Text: msgText, Markdown: true}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -25,9 +24,13 @@ func (m *manager) handleRulesButton(_ userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleCreateButton(u userModel.User, chatID int64) error {
-	msg := tgbotapi.NewMessage(chatID, resource.TextSettingsMsg)
-	msg.ReplyMarkup = tgbotapi.NewReplyKeyboard(tgbotapi.NewKeyboardButtonRow(resource.LeaveButton))
-	if _, err := m.tg.Send(msg); err != nil {
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{
+		ChatID:   chatID,
+		Text:     lang.TextSettingsMsg,
+		Keyboard: tgbotapi.NewReplyKeyboard(tgbotapi.NewKeyboardButtonRow(lang.LeaveButton())),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -36,8 +39,12 @@ func (m *manager) handleCreateButton(u userModel.User, chatID int64) error {
 		chatID,
 		u.ID,
 		u.Username,
+		lang.Locale,
+		m.packDB,
+		m.bloopsDB,
 		m.builderDoneFn,
 		m.builderWarnFn,
+		m.builderExpireFn,
 		m.config.BuildingTimeout,
 	)
 	if err != nil {
@@ -54,15 +61,19 @@ func (m *manager) handleCreateButton(u userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleButtonExit(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
 	if session, ok := m.userMatchSession(u.ID); ok {
 		session.RemovePlayer(u.ID)
 	}
 
 	m.resetUserSessions(u.ID)
 
-	msg := tgbotapi.NewMessage(chatID, resource.TextLeavingSessionsMsg)
-	msg.ReplyMarkup = resource.CommonButtons
-	if _, err := m.tg.Send(msg); err != nil {
+	msg := transport.Message{
+		ChatID:   chatID,
+		Text:     lang.TextLeavingSessionsMsg,
+		Keyboard: lang.CommonButtons(),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -70,14 +81,14 @@ func (m *manager) handleButtonExit(u userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleProfileButton(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
 	stat, err := m.statDB.FetchProfileStat(u.ID)
 	if err != nil && !errors.Is(err, statDb.ErrNotFound) {
 		return fmt.Errorf("fetch profile stat: %w", err)
 	}
 
-	msg := tgbotapi.NewMessage(chatID, renderProfile(u, stat))
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	if _, err := m.tg.Send(msg); err != nil {
+	msg := transport.Message{ChatID: chatID, Text: renderProfile(lang, u, stat), Markdown: true}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -85,55 +96,32 @@ func (m *manager) handleProfileButton(u userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleJoinButton(u userModel.User, chatID int64) error {
-	msg := tgbotapi.NewMessage(chatID, resource.TextSendJoinedCodeMsg)
-	msg.ReplyMarkup = resource.CommonButtons
-	if _, err := m.tg.Send(msg); err != nil {
+	return m.joinByCode(u, chatID, false)
+}
+
+func (m *manager) handleWatchButton(u userModel.User, chatID int64) error {
+	return m.joinByCode(u, chatID, true)
+}
+
+// joinByCode asks the user for the game code and joins the game as a player or as a spectator
+func (m *manager) joinByCode(u userModel.User, chatID int64, spectator bool) error {
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{
+		ChatID:   chatID,
+		Text:     lang.TextSendJoinedCodeMsg,
+		Keyboard: lang.CommonButtons(),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
 	m.registerCommandCbHandler(u.ID, func(msg string) error {
-		n, err := strconv.Atoi(msg)
-		if err != nil {
-			return fmt.Errorf("strconv: %w", err)
-		}
-
-		if session, ok := m.matchSession(int64(n)); ok {
-			if err := session.AddPlayer(matchstateModel.NewPlayer(chatID, u, false)); err != nil {
-				return fmt.Errorf("add player: %w", err)
-			}
-
-			greetingText := resource.TextJoinedGameMsg
-
-			row := tgbotapi.NewKeyboardButtonRow()
-			if session.Config.AuthorID == u.ID {
-				greetingText += resource.TextAuthorGreetingMsg
-				row = append(row, resource.StartButton)
-			}
-
-			row = append(row, resource.LeaveButton, resource.GameSettingButton)
-			msg := tgbotapi.NewMessage(chatID, greetingText)
-			msg.ParseMode = tgbotapi.ModeMarkdown
-			msg.ReplyMarkup = tgbotapi.NewReplyKeyboard(
-				row,
-				tgbotapi.NewKeyboardButtonRow(resource.RatingButton, resource.RulesButton),
-			)
-
-			if _, err := m.tg.Send(msg); err != nil {
-				return fmt.Errorf("send msg: %w", err)
-			}
-
-			m.mtx.Lock()
-			m.userMatchSessions[u.ID] = session
-			delete(m.commandCbHandlers, u.ID)
-			m.mtx.Unlock()
-		} else {
-			msg := tgbotapi.NewMessage(chatID, resource.TextGameRoomNotFoundMsg)
-			if _, err := m.tg.Send(msg); err != nil {
-				return fmt.Errorf("send msg: %w", err)
-			}
+		code, ok := m.codes.Parse(msg)
+		if !ok {
+			return m.joinFailed(u, chatID, lang.TextGameRoomNotFoundMsg)
 		}
 
-		return nil
+		return m.joinGame(u, chatID, code, spectator)
 	})
 
 	return nil
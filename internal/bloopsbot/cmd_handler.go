@@ -6,28 +6,52 @@ import (
 	"strings"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/bloopsbot/util"
 	matchstateModel "github.com/bloops-games/bloops/internal/database/matchstate/model"
 	userDb "github.com/bloops-games/bloops/internal/database/user/database"
 	userModel "github.com/bloops-games/bloops/internal/database/user/model"
-	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 )
 
 func (m *manager) handleStartCommand(u userModel.User, chatID int64) error {
-	msg := tgbotapi.NewMessage(chatID, fmt.Sprintf(resource.TextGreetingMsg, u.FirstName))
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	msg.ReplyMarkup = resource.CommonButtons
+	return m.handleStartPayload(u, chatID, "")
+}
 
-	if _, err := m.tg.Send(msg); err != nil {
+// handleStartPayload greets the user and joins the game if the bot was opened by an invite link
+func (m *manager) handleStartPayload(u userModel.User, chatID int64, payload string) error {
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{
+		ChatID:   chatID,
+		Text:     fmt.Sprintf(lang.TextGreetingMsg, u.FirstName),
+		Markdown: true,
+		Keyboard: lang.CommonButtons(),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
-	return nil
+	code, ok := util.ParseInvite(payload)
+	if !ok {
+		return nil
+	}
+
+	_, building := m.userBuildingSession(u.ID)
+	if _, playing := m.userMatchSession(u.ID); playing || building {
+		msg := transport.Message{ChatID: chatID, Text: fmt.Sprintf(lang.TextGroupPlayerInGameMsg, u.FirstName)}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	return m.joinGame(u, chatID, code, false)
 }
 
 func (m *manager) handleBanCommand(u userModel.User, chatID int64) error {
-	msg := tgbotapi.NewMessage(chatID, resource.TextBanMsg)
-	msg.ReplyMarkup = resource.CommonButtons
-	if _, err := m.tg.Send(msg); err != nil {
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{ChatID: chatID, Text: lang.TextBanMsg, Keyboard: lang.CommonButtons()}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -36,7 +60,10 @@ func (m *manager) handleBanCommand(u userModel.User, chatID int64) error {
 		banned, err := m.userDB.FetchByUsername(msg)
 		if err != nil {
 			if errors.Is(err, userDb.ErrNotFound) {
-				if _, err := m.tg.Send(tgbotapi.NewMessage(u.ID, fmt.Sprintf("Пользователь не найден: %s", msg))); err != nil {
+				if _, err := m.tg.SendText(transport.Message{
+					ChatID: u.ID,
+					Text:   fmt.Sprintf(lang.TextBanUserNotFoundMsg, msg),
+				}); err != nil {
 					return fmt.Errorf("send msg: %w", err)
 				}
 			}
@@ -45,7 +72,10 @@ func (m *manager) handleBanCommand(u userModel.User, chatID int64) error {
 		}
 
 		if banned.Admin {
-			if _, err := m.tg.Send(tgbotapi.NewMessage(chatID, "Нельзя забанить администратора")); err != nil {
+			if _, err := m.tg.SendText(transport.Message{
+				ChatID: chatID,
+				Text:   lang.TextBanAdminMsg,
+			}); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 
@@ -57,7 +87,12 @@ func (m *manager) handleBanCommand(u userModel.User, chatID int64) error {
 			return fmt.Errorf("user db store: %w", err)
 		}
 
-		if _, err := m.tg.Send(tgbotapi.NewMessage(u.ID, fmt.Sprintf("Пользователь забанен: %s", msg))); err != nil {
+		m.detachUser(banned.ID)
+
+		if _, err := m.tg.SendText(transport.Message{
+			ChatID: u.ID,
+			Text:   fmt.Sprintf(lang.TextUserBannedMsg, msg),
+		}); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -72,8 +107,9 @@ func (m *manager) handleBanCommand(u userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleProfileCmd(u userModel.User, chatID int64) error {
-	msg := tgbotapi.NewMessage(chatID, resource.TextSendProfileMsg)
-	if _, err := m.tg.Send(msg); err != nil {
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{ChatID: chatID, Text: lang.TextSendProfileMsg}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -83,9 +119,8 @@ func (m *manager) handleProfileCmd(u userModel.User, chatID int64) error {
 		u, err := m.userDB.FetchByUsername(username)
 		if err != nil {
 			if errors.Is(err, userDb.ErrNotFound) {
-				msg := tgbotapi.NewMessage(chatID, resource.TextProfileCmdUserNotFound)
-				msg.ParseMode = tgbotapi.ModeMarkdown
-				if _, err := m.tg.Send(msg); err != nil {
+				msg := transport.Message{ChatID: chatID, Text: lang.TextProfileCmdUserNotFound, Markdown: true}
+				if _, err := m.tg.SendText(msg); err != nil {
 					return fmt.Errorf("send msg: %w", err)
 				}
 				return nil
@@ -99,9 +134,8 @@ func (m *manager) handleProfileCmd(u userModel.User, chatID int64) error {
 			return fmt.Errorf("fetch profile stat: %w", err)
 		}
 
-		msg := tgbotapi.NewMessage(chatID, renderProfile(u, stat))
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		if _, err := m.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: chatID, Text: renderProfile(lang, u, stat), Markdown: true}
+		if _, err := m.tg.SendText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -116,9 +150,9 @@ func (m *manager) handleProfileCmd(u userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleFeedbackCommand(u userModel.User, chatID int64) error {
-	msg := tgbotapi.NewMessage(chatID, resource.TextFeedbackMsg)
-	msg.ReplyMarkup = resource.CommonButtons
-	if _, err := m.tg.Send(msg); err != nil {
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{ChatID: chatID, Text: lang.TextFeedbackMsg, Keyboard: lang.CommonButtons()}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
@@ -129,10 +163,10 @@ func (m *manager) handleFeedbackCommand(u userModel.User, chatID int64) error {
 				return fmt.Errorf("fetch by username: %w", err)
 			}
 
-			if _, err := m.tg.Send(tgbotapi.NewMessage(
-				admin.ID,
-				fmt.Sprintf("Прилетел фидбек от пользователя: %s", msg),
-			)); err != nil {
+			if _, err := m.tg.SendText(transport.Message{
+				ChatID: admin.ID,
+				Text:   fmt.Sprintf(resource.Lang(admin.LanguageCode).TextFeedbackReceivedMsg, msg),
+			}); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 		}
@@ -148,9 +182,10 @@ func (m *manager) handleFeedbackCommand(u userModel.User, chatID int64) error {
 }
 
 func (m *manager) handleRegisterOfflinePlayerCmd(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
 	if session, ok := m.userMatchSession(u.ID); ok {
-		msg := tgbotapi.NewMessage(chatID, resource.TextSendOfflinePlayerUsernameMsg)
-		if _, err := m.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: chatID, Text: lang.TextSendOfflinePlayerUsernameMsg}
+		if _, err := m.tg.SendText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -161,8 +196,8 @@ func (m *manager) handleRegisterOfflinePlayerCmd(u userModel.User, chatID int64)
 				return err
 			}
 
-			msg := tgbotapi.NewMessage(chatID, resource.TextOfflinePlayerAdded)
-			if _, err := m.tg.Send(msg); err != nil {
+			msg := transport.Message{ChatID: chatID, Text: lang.TextOfflinePlayerAdded}
+			if _, err := m.tg.SendText(msg); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 
@@ -173,8 +208,8 @@ func (m *manager) handleRegisterOfflinePlayerCmd(u userModel.User, chatID int64)
 			return nil
 		})
 	} else {
-		msg := tgbotapi.NewMessage(chatID, resource.TextGameRoomNotFound)
-		if _, err := m.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: chatID, Text: lang.TextGameRoomNotFound}
+		if _, err := m.tg.SendText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 	}
@@ -16,6 +16,11 @@ type Config struct {
 	Port string `envconfig:"BLOOP_PORT" default:"1234"`
 	// profile port
 	ProfPort string `envconfig:"BLOOP_PROF_PORT" default:"8888"`
+	// Credentials of the REST API: basic auth with the username and password or bearer auth with the token,
+	// the API is disabled if neither the username with the password nor the token is set
+	APIUsername string `envconfig:"BLOOP_API_USERNAME"`
+	APIPassword string `envconfig:"BLOOP_API_PASSWORD"`
+	APIToken    string `envconfig:"BLOOP_API_TOKEN"`
 	// Http server for POST requests from telegram(if you use web hooks)
 	BotWebhookAddr string `envconfig:"BLOOP_WEBHOOK_ADDR" default:":4444"`
 	// Not working in the CLI application
@@ -26,10 +31,37 @@ type Config struct {
 	BotWebhookHookURL string `envconfig:"BLOOP_BOT_WEBHOOK_URL"`
 	// Telegram bot token
 	BotToken string `envconfig:"BLOOP_BOT_TOKEN"`
+	// Username of the bot in the invite links, the username of the bot account is used if not set
+	BotUsername string `envconfig:"BLOOP_BOT_USERNAME"`
+	// Directory with the word lists of the categories <dir>/<locale>/<name>.txt,
+	// the typed answers are not checked by the dictionary if it is not set
+	DictionaryDir string `envconfig:"BLOOP_DICTIONARY_DIR"`
+	// Game codes: the number of the characters, the characters and the word codes like red-fox-42,
+	// the code of the finished game is given to another game after the expiry. The default alphabet leaves out
+	// the look-alike characters, the word codes need the length of 4 at most with it
+	CodeLength   int           `envconfig:"BLOOP_CODE_LENGTH" default:"6"`
+	CodeAlphabet string        `envconfig:"BLOOP_CODE_ALPHABET" default:"ABCDEFGHJKLMNPQRSTUVWXYZ23456789"`
+	CodeWords    bool          `envconfig:"BLOOP_CODE_WORDS" default:"false"`
+	CodeExpiry   time.Duration `envconfig:"BLOOP_CODE_EXPIRY" default:"1h"`
+	// Failed attempts to join a game with a wrong code or PIN before the user is locked out for the lockout time,
+	// the attempts are not limited if the number is zero
+	JoinAttempts int           `envconfig:"BLOOP_JOIN_ATTEMPTS" default:"5"`
+	JoinLockout  time.Duration `envconfig:"BLOOP_JOIN_LOCKOUT" default:"15m"`
+	// Users in the matchmaking queue that make a new public game and the time they wait before leaving the queue
+	MatchmakingPlayers int           `envconfig:"BLOOP_MATCHMAKING_PLAYERS" default:"3"`
+	MatchmakingTimeout time.Duration `envconfig:"BLOOP_MATCHMAKING_TIMEOUT" default:"10m"`
+	// Number of the latest monthly leaderboards that are kept, all of them are kept if the number is zero
+	LeaderboardSeasons int `envconfig:"BLOOP_LEADERBOARD_SEASONS" default:"12"`
+	// Games with fewer players without the offline players are not rated,
+	// the ratings are shown next to the names of the players in the games if enabled
+	RatingMinPlayers int  `envconfig:"BLOOP_RATING_MIN_PLAYERS" default:"3"`
+	RatingNames      bool `envconfig:"BLOOP_RATING_NAMES" default:"false"`
 	// Waiting time to complete the game creation session
 	BuildingTimeout time.Duration `envconfig:"BLOOP_BUILDING_TIMEOUT" default:"60m"`
 	// Waiting time for the game session to end
-	PlayingTimeout   time.Duration `envconfig:"BLOOP_PLAYING_TIMEOUT" default:"24h"`
+	PlayingTimeout time.Duration `envconfig:"BLOOP_PLAYING_TIMEOUT" default:"24h"`
+	// Waiting time for the sessions to be saved on shutdown
+	ShutdownTimeout  time.Duration `envconfig:"BLOOP_SHUTDOWN_TIMEOUT" default:"30s"`
 	TgBotPollTimeout time.Duration `envconfig:"BLOOP_TG_BOT_POLL_TIMEOUT" default:"60s"`
 	DB               database.Config
 }
@@ -0,0 +1,266 @@
+package gamecode
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/valyala/fastrand"
+)
+
+var (
+	ErrExhausted     = errors.New("no free game codes left")
+	ErrInvalidConfig = errors.New("invalid game code config")
+)
+
+// random attempts before the allocator scans the code space for a free code
+const randomAttempts = 16
+
+var (
+	adjectives = []string{
+		"red", "blue", "green", "pink", "gold", "gray", "cyan", "lime",
+		"bold", "calm", "cool", "fast", "kind", "loud", "wild", "wise",
+		"brave", "happy", "lucky", "quiet", "shy", "sly", "tiny", "warm",
+		"fancy", "funny", "jolly", "proud", "rapid", "sunny", "witty", "zesty",
+	}
+	nouns = []string{
+		"fox", "owl", "cat", "dog", "elk", "yak", "bee", "ant",
+		"bear", "crab", "deer", "duck", "frog", "goat", "hawk", "lion",
+		"mole", "moth", "newt", "seal", "swan", "toad", "wolf", "wren",
+		"eagle", "horse", "koala", "lemur", "otter", "panda", "shark", "tiger",
+	}
+)
+
+// Config of the game codes
+type Config struct {
+	// Number of the characters of the code, the number of the characters after the words if the word codes are used
+	Length int
+	// Characters of the code, the case of the letters is ignored
+	Alphabet string
+	// Word codes like red-fox-42
+	Words bool
+	// Time after the game is finished before its code is given to another game
+	Expiry time.Duration
+}
+
+// Allocator gives out unique codes to the games and takes them back after the games are finished
+type Allocator struct {
+	alphabet []rune
+	length   int
+	words    bool
+	expiry   time.Duration
+	// number of the codes of the suffix
+	space int64
+
+	mtx sync.Mutex
+	// key: code, value: the time the code was released, zero if the code is in use
+	codes map[int64]time.Time
+}
+
+func NewAllocator(config Config) (*Allocator, error) {
+	alphabet := []rune(strings.ToUpper(config.Alphabet))
+	if len(alphabet) < 2 || config.Length < 1 {
+		return nil, fmt.Errorf("%w: the alphabet needs at least 2 characters and the length at least 1", ErrInvalidConfig)
+	}
+
+	seen := make(map[rune]struct{}, len(alphabet))
+	for _, r := range alphabet {
+		if _, ok := seen[r]; ok || unicode.IsSpace(r) || r == '-' || r == '_' {
+			return nil, fmt.Errorf("%w: unexpected character %q in the alphabet", ErrInvalidConfig, r)
+		}
+
+		seen[r] = struct{}{}
+	}
+
+	total := int64(1)
+	if config.Words {
+		total = int64(len(adjectives) * len(nouns))
+	}
+
+	space := int64(1)
+	for i := 0; i < config.Length; i++ {
+		space *= int64(len(alphabet))
+		if space*total > math.MaxUint32 {
+			return nil, fmt.Errorf("%w: the length %d is too long", ErrInvalidConfig, config.Length)
+		}
+	}
+
+	return &Allocator{
+		alphabet: alphabet,
+		length:   config.Length,
+		words:    config.Words,
+		expiry:   config.Expiry,
+		space:    space,
+		codes:    map[int64]time.Time{},
+	}, nil
+}
+
+// size returns the number of the codes
+func (a *Allocator) size() int64 {
+	if a.words {
+		return a.space * int64(len(adjectives)*len(nouns))
+	}
+
+	return a.space
+}
+
+// Allocate returns a code that is not used by any game and was not released recently
+func (a *Allocator) Allocate() (int64, error) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+
+	size := a.size()
+	for i := 0; i < randomAttempts; i++ {
+		code := int64(fastrand.Uint32n(uint32(size)))
+		if a.free(code) {
+			a.codes[code] = time.Time{}
+			return code, nil
+		}
+	}
+
+	start := int64(fastrand.Uint32n(uint32(size)))
+	for i := int64(0); i < size; i++ {
+		code := (start + i) % size
+		if a.free(code) {
+			a.codes[code] = time.Time{}
+			return code, nil
+		}
+	}
+
+	return 0, ErrExhausted
+}
+
+// free checks the code is not in use and has expired, the expired codes are forgotten.
+// Must be called under the lock
+func (a *Allocator) free(code int64) bool {
+	releasedAt, ok := a.codes[code]
+	if !ok {
+		return true
+	}
+
+	if releasedAt.IsZero() || time.Since(releasedAt) < a.expiry {
+		return false
+	}
+
+	delete(a.codes, code)
+
+	return true
+}
+
+// Reserve marks the code of the restored game as used
+func (a *Allocator) Reserve(code int64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	a.codes[code] = time.Time{}
+}
+
+// Release gives the code of the finished game back, the code is recycled after the expiry
+func (a *Allocator) Release(code int64) {
+	a.mtx.Lock()
+	defer a.mtx.Unlock()
+	if _, ok := a.codes[code]; ok {
+		a.codes[code] = time.Now()
+	}
+}
+
+// Format returns the human-friendly form of the code
+func (a *Allocator) Format(code int64) string {
+	if code < 0 || code >= a.size() {
+		return strconv.FormatInt(code, 10)
+	}
+
+	suffix := make([]rune, a.length)
+	n := code % a.space
+	for i := a.length - 1; i >= 0; i-- {
+		suffix[i] = a.alphabet[n%int64(len(a.alphabet))]
+		n /= int64(len(a.alphabet))
+	}
+
+	if !a.words {
+		return string(suffix)
+	}
+
+	n = code / a.space
+	return fmt.Sprintf("%s-%s-%s", adjectives[n/int64(len(nouns))], nouns[n%int64(len(nouns))], strings.ToLower(string(suffix)))
+}
+
+// Parse returns the code from the text typed by the user, the case and the whitespaces are ignored.
+// Only the codes in the format of the allocator are accepted, the internal numbers of the games are not
+func (a *Allocator) Parse(text string) (int64, bool) {
+	text = strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '_' {
+			return '-'
+		}
+
+		return unicode.ToUpper(r)
+	}, text)
+
+	var parts []string
+	for _, part := range strings.Split(text, "-") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+
+	var prefix int64
+	if a.words {
+		if len(parts) != 3 {
+			return 0, false
+		}
+
+		adjective, ok := indexOfWord(adjectives, strings.ToLower(parts[0]))
+		if !ok {
+			return 0, false
+		}
+
+		noun, ok := indexOfWord(nouns, strings.ToLower(parts[1]))
+		if !ok {
+			return 0, false
+		}
+
+		prefix = int64(adjective*len(nouns)+noun) * a.space
+		parts = parts[2:]
+	}
+
+	suffix := []rune(strings.Join(parts, ""))
+	if len(suffix) == 0 || len(suffix) > a.length {
+		return 0, false
+	}
+
+	var n int64
+	for _, r := range suffix {
+		idx, ok := indexOfRune(a.alphabet, r)
+		if !ok {
+			return 0, false
+		}
+
+		n = n*int64(len(a.alphabet)) + int64(idx)
+	}
+
+	return prefix + n, true
+}
+
+func indexOfWord(words []string, word string) (int, bool) {
+	for i := range words {
+		if words[i] == word {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
+
+func indexOfRune(runes []rune, r rune) (int, bool) {
+	for i := range runes {
+		if runes[i] == r {
+			return i, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,127 @@
+package gamecode
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestAllocator(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewAllocator(Config{Length: 1, Alphabet: "ab", Expiry: time.Hour})
+	if err != nil {
+		t.Fatalf("new allocator: %v", err)
+	}
+
+	a.Reserve(0)
+	code, err := a.Allocate()
+	if err != nil {
+		t.Fatalf("allocate: %v", err)
+	}
+
+	if code != 1 {
+		t.Fatalf("expected the only free code 1, got %d", code)
+	}
+
+	if _, err := a.Allocate(); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("expected the codes to be exhausted, got %v", err)
+	}
+
+	// the released code is not given out until it expires
+	a.Release(1)
+	if _, err := a.Allocate(); !errors.Is(err, ErrExhausted) {
+		t.Fatalf("expected the released code to be held, got %v", err)
+	}
+
+	a.mtx.Lock()
+	a.codes[1] = time.Now().Add(-2 * time.Hour)
+	a.mtx.Unlock()
+	if code, err := a.Allocate(); err != nil || code != 1 {
+		t.Fatalf("expected the expired code to be recycled, got %d %v", code, err)
+	}
+}
+
+func TestAllocatorFormat(t *testing.T) {
+	t.Parallel()
+
+	digits, err := NewAllocator(Config{Length: 4, Alphabet: "0123456789"})
+	if err != nil {
+		t.Fatalf("new allocator: %v", err)
+	}
+
+	letters, err := NewAllocator(Config{Length: 3, Alphabet: "abcdefghjkmnpqrstuvwxyz"})
+	if err != nil {
+		t.Fatalf("new allocator: %v", err)
+	}
+
+	words, err := NewAllocator(Config{Length: 2, Alphabet: "0123456789", Words: true})
+	if err != nil {
+		t.Fatalf("new allocator: %v", err)
+	}
+
+	testCases := []struct {
+		name      string
+		allocator *Allocator
+		code      int64
+		formatted string
+		inputs    []string
+	}{
+		{name: "digits", allocator: digits, code: 42, formatted: "0042", inputs: []string{"0042", "42", " 00 42 "}},
+		{name: "letters", allocator: letters, code: 25, formatted: "ABC", inputs: []string{"abc", "A b C", "a-bc"}},
+		{name: "words", allocator: words, code: 42, formatted: "red-fox-42", inputs: []string{"Red Fox 42", "red_fox-42", " RED-FOX-42"}},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if formatted := tc.allocator.Format(tc.code); formatted != tc.formatted {
+				t.Errorf("expected %s, got %s", tc.formatted, formatted)
+			}
+
+			for _, input := range append(tc.inputs, tc.formatted) {
+				if code, ok := tc.allocator.Parse(input); !ok || code != tc.code {
+					t.Errorf("Parse(%q): expected %d, got %d %t", input, tc.code, code, ok)
+				}
+			}
+		})
+	}
+
+	for _, input := range []string{"", "red-owl", "blue-xyz-1", "abc"} {
+		if code, ok := words.Parse(input); ok {
+			t.Errorf("Parse(%q): expected the invalid code, got %d", input, code)
+		}
+	}
+
+	// the code outside of the code space is shown as the number but the number is not a code
+	if formatted := digits.Format(123456); formatted != "123456" {
+		t.Errorf("expected 123456, got %s", formatted)
+	}
+
+	for _, input := range []string{"123456", "42"} {
+		if code, ok := letters.Parse(input); ok {
+			t.Errorf("Parse(%q): expected the internal number not to be accepted, got %d", input, code)
+		}
+	}
+
+	if code, ok := digits.Parse("123456"); ok {
+		t.Errorf("Parse(123456): expected the too long code not to be accepted, got %d", code)
+	}
+}
+
+func TestNewAllocatorInvalid(t *testing.T) {
+	t.Parallel()
+
+	for _, config := range []Config{
+		{Length: 4, Alphabet: "a"},
+		{Length: 0, Alphabet: "01"},
+		{Length: 4, Alphabet: "aA"},
+		{Length: 64, Alphabet: "01"},
+	} {
+		if _, err := NewAllocator(config); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("expected the invalid config %+v, got %v", config, err)
+		}
+	}
+}
@@ -0,0 +1,186 @@
+package bloopsbot
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/builder"
+	"github.com/bloops-games/bloops/internal/bloopsbot/match"
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	matchstateModel "github.com/bloops-games/bloops/internal/database/matchstate/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+)
+
+func (m *manager) handleGroupGameCommand(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
+	m.mtx.RLock()
+	_, building := m.chatBuildingSessions[chatID]
+	_, playing := m.chatMatchSessions[chatID]
+	_, userBuilding := m.userBuildingSessions[u.ID]
+	_, userPlaying := m.userMatchSessions[u.ID]
+	m.mtx.RUnlock()
+
+	if building || playing {
+		msg := transport.Message{ChatID: chatID, Text: lang.TextGroupGameExistsMsg}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	if userBuilding || userPlaying {
+		msg := transport.Message{ChatID: chatID, Text: fmt.Sprintf(lang.TextGroupPlayerInGameMsg, u.FirstName)}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	session, err := builder.NewSession(
+		m.tg,
+		chatID,
+		u.ID,
+		u.Username,
+		lang.Locale,
+		m.packDB,
+		m.bloopsDB,
+		m.builderDoneFn,
+		m.builderWarnFn,
+		m.builderExpireFn,
+		m.config.BuildingTimeout,
+	)
+	if err != nil {
+		return fmt.Errorf("new builder session: %w", err)
+	}
+
+	session.Group = true
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	delete(m.commandCbHandlers, u.ID)
+	m.userBuildingSessions[u.ID] = session
+	m.chatBuildingSessions[chatID] = session
+	session.Run(m.ctxSess)
+
+	return nil
+}
+
+func (m *manager) handleGroupJoinCommand(u userModel.User, chatID int64) error {
+	return m.groupJoin(u, chatID, false)
+}
+
+func (m *manager) handleGroupWatchCommand(u userModel.User, chatID int64) error {
+	return m.groupJoin(u, chatID, true)
+}
+
+// groupJoin joins the user to the game of the group chat as a player or as a spectator
+func (m *manager) groupJoin(u userModel.User, chatID int64, spectator bool) error {
+	lang := resource.Lang(u.LanguageCode)
+	session, ok := m.chatMatchSession(chatID)
+	if !ok {
+		msg := transport.Message{ChatID: chatID, Text: lang.TextGroupGameNotFoundMsg}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	if userSession, ok := m.userMatchSession(u.ID); ok {
+		if userSession == session {
+			return nil
+		}
+
+		msg := transport.Message{ChatID: chatID, Text: fmt.Sprintf(lang.TextGroupPlayerInGameMsg, u.FirstName)}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := m.joinGroupGame(session, u, spectator); err != nil {
+		return fmt.Errorf("join group game: %w", err)
+	}
+
+	return nil
+}
+
+func (m *manager) handleGroupLeaveCommand(u userModel.User, chatID int64) error {
+	session, ok := m.chatMatchSession(chatID)
+	if !ok {
+		return nil
+	}
+
+	if userSession, ok := m.userMatchSession(u.ID); ok && userSession == session {
+		session.RemovePlayer(u.ID)
+
+		m.mtx.Lock()
+		delete(m.userMatchSessions, u.ID)
+		m.mtx.Unlock()
+	}
+
+	return nil
+}
+
+// groupGameCreated binds the built game to the group chat and joins the author to it
+func (m *manager) groupGameCreated(bs *builder.Session, session *match.Session) error {
+	m.mtx.Lock()
+	m.chatMatchSessions[bs.ChatID] = session
+	m.mtx.Unlock()
+
+	msg := transport.Message{ChatID: bs.ChatID, Text: session.Lang().TextGroupGameCreatedMsg, Markdown: true}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	author, err := m.userDB.Fetch(bs.AuthorID)
+	if err != nil {
+		return fmt.Errorf("fetch author: %w", err)
+	}
+
+	if err := m.joinGroupGame(session, author, false); err != nil {
+		return fmt.Errorf("join group game: %w", err)
+	}
+
+	return nil
+}
+
+func (m *manager) joinGroupGame(session *match.Session, u userModel.User, spectator bool) error {
+	player := matchstateModel.NewPlayer(session.Config.ChatID, u, false)
+	text := session.Lang().TextGroupPlayerJoinedMsg
+	if spectator {
+		player = matchstateModel.NewSpectator(session.Config.ChatID, u)
+		text = session.Lang().TextGroupSpectatorMsg
+	}
+
+	// the spectators always get in, only the players are limited
+	if err := session.AddPlayer(player); err != nil {
+		if errors.Is(err, match.ErrGameFull) {
+			if _, err := m.tg.SendText(transport.Message{ChatID: session.Config.ChatID, Text: session.Lang().TextGameFullMsg}); err != nil {
+				return fmt.Errorf("send msg: %w", err)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("add player: %w", err)
+	}
+
+	m.mtx.Lock()
+	m.userMatchSessions[u.ID] = session
+	m.mtx.Unlock()
+
+	msg := transport.Message{
+		ChatID: session.Config.ChatID,
+		Text:   fmt.Sprintf(text, u.FirstName),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
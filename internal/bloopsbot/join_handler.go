@@ -0,0 +1,251 @@
+package bloopsbot
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/match"
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	matchstateModel "github.com/bloops-games/bloops/internal/database/matchstate/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	joinRequestPrefix = "join:"
+	joinApprovePrefix = joinRequestPrefix + "ok:"
+	joinDenyPrefix    = joinRequestPrefix + "no:"
+)
+
+// joinRequest is the request to join the private game waiting for the answer of the author
+type joinRequest struct {
+	user      userModel.User
+	chatID    int64
+	session   *match.Session
+	spectator bool
+}
+
+// joinGame adds the user to the game with the code as a player or as a spectator,
+// the request to join a private game is sent to the author first
+func (m *manager) joinGame(u userModel.User, chatID, code int64, spectator bool) error {
+	if locked, err := m.joinLocked(u, chatID); err != nil || locked {
+		return err
+	}
+
+	lang := resource.Lang(u.LanguageCode)
+	session, ok := m.matchSession(code)
+	if !ok {
+		return m.joinFailed(u, chatID, lang.TextGameRoomNotFoundMsg)
+	}
+
+	if !session.Config.Private || session.Config.AuthorID == u.ID {
+		return m.addToGame(u, chatID, session, spectator)
+	}
+
+	if session.Config.PIN == "" {
+		return m.requestJoin(u, chatID, session, spectator)
+	}
+
+	msg := transport.Message{ChatID: chatID, Text: lang.TextSendPINMsg, Keyboard: lang.CommonButtons()}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	m.registerCommandCbHandler(u.ID, func(msg string) error {
+		if locked, err := m.joinLocked(u, chatID); err != nil || locked {
+			return err
+		}
+
+		if strings.TrimSpace(msg) != session.Config.PIN {
+			return m.joinFailed(u, chatID, lang.TextWrongPINMsg)
+		}
+
+		m.mtx.Lock()
+		delete(m.commandCbHandlers, u.ID)
+		m.mtx.Unlock()
+
+		return m.requestJoin(u, chatID, session, spectator)
+	})
+
+	return nil
+}
+
+// addToGame adds the user to the game and shows the game menu
+func (m *manager) addToGame(u userModel.User, chatID int64, session *match.Session, spectator bool) error {
+	player := matchstateModel.NewPlayer(chatID, u, false)
+	if spectator {
+		player = matchstateModel.NewSpectator(chatID, u)
+	}
+
+	lang := session.Lang()
+	if err := session.AddPlayer(player); err != nil {
+		if errors.Is(err, match.ErrGameFull) {
+			if _, err := m.tg.SendText(transport.Message{ChatID: chatID, Text: lang.TextGameFullMsg}); err != nil {
+				return fmt.Errorf("send msg: %w", err)
+			}
+
+			return nil
+		}
+
+		return fmt.Errorf("add player: %w", err)
+	}
+
+	greetingText := lang.TextJoinedGameMsg
+	if spectator {
+		greetingText = lang.TextWatchingGameMsg
+	}
+
+	row := tgbotapi.NewKeyboardButtonRow()
+	infoRow := tgbotapi.NewKeyboardButtonRow(lang.RatingButton(), lang.RulesButton())
+	if session.Config.AuthorID == u.ID {
+		greetingText += lang.TextAuthorGreetingMsg
+		row = append(row, lang.StartButton())
+		infoRow = append(infoRow, lang.ManageButton())
+	}
+
+	row = append(row, lang.LeaveButton(), lang.GameSettingButton())
+	msg := transport.Message{
+		ChatID:   chatID,
+		Text:     greetingText,
+		Markdown: true,
+		Keyboard: tgbotapi.NewReplyKeyboard(row, infoRow),
+	}
+
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	m.mtx.Lock()
+	m.userMatchSessions[u.ID] = session
+	delete(m.commandCbHandlers, u.ID)
+	m.dequeue(u.ID)
+	m.mtx.Unlock()
+
+	return nil
+}
+
+// requestJoin forwards the request to join the private game to the author
+func (m *manager) requestJoin(u userModel.User, chatID int64, session *match.Session, spectator bool) error {
+	m.mtx.Lock()
+	m.joinRequests[u.ID] = joinRequest{user: u, chatID: chatID, session: session, spectator: spectator}
+	m.mtx.Unlock()
+
+	lang := session.Lang()
+	text := lang.TextJoinRequestMsg
+	if spectator {
+		text = lang.TextWatchRequestMsg
+	}
+
+	userID := strconv.FormatInt(u.ID, 10)
+	msg := transport.Message{
+		ChatID:   session.Config.AuthorID,
+		Text:     fmt.Sprintf(text, u.FirstName),
+		Markdown: true,
+		Keyboard: tgbotapi.NewInlineKeyboardMarkup(tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(lang.TextJoinApprove, joinApprovePrefix+userID),
+			tgbotapi.NewInlineKeyboardButtonData(lang.TextJoinDeny, joinDenyPrefix+userID),
+		)),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	msg = transport.Message{ChatID: chatID, Text: resource.Lang(u.LanguageCode).TextJoinRequestSentMsg}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// answerJoinRequest lets the user in the private game or denies the request by the choice of the author
+func (m *manager) answerJoinRequest(u userModel.User, query *tgbotapi.CallbackQuery) error {
+	approve := strings.HasPrefix(query.Data, joinApprovePrefix)
+	userID, err := strconv.ParseInt(strings.TrimPrefix(strings.TrimPrefix(query.Data, joinApprovePrefix), joinDenyPrefix), 10, 64)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	if err := m.tg.AnswerCallback(query.ID, ""); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	m.mtx.Lock()
+	req, ok := m.joinRequests[userID]
+	if ok && req.session.Config.AuthorID == u.ID {
+		delete(m.joinRequests, userID)
+	}
+	m.mtx.Unlock()
+
+	if !ok || req.session.Config.AuthorID != u.ID || query.Message == nil {
+		return nil
+	}
+
+	lang := req.session.Lang()
+	text := fmt.Sprintf(lang.TextJoinRequestDenied, req.user.FirstName)
+	switch _, alive := m.matchSession(req.session.Config.Code); {
+	case !alive:
+		text = lang.TextGameRoomNotFoundMsg
+	case approve:
+		if err := m.addToGame(req.user, req.chatID, req.session, req.spectator); err != nil {
+			return fmt.Errorf("add to game: %w", err)
+		}
+
+		text = fmt.Sprintf(lang.TextJoinApprovedMsg, req.user.FirstName)
+	default:
+		userLang := resource.Lang(req.user.LanguageCode)
+		msg := transport.Message{ChatID: req.chatID, Text: userLang.TextJoinDeniedMsg, Keyboard: userLang.CommonButtons()}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+	}
+
+	msg := transport.Message{ChatID: query.Message.Chat.ID, MessageID: query.Message.MessageID, Text: text, Markdown: true}
+	if err := m.tg.EditText(msg); err != nil {
+		return fmt.Errorf("edit msg: %w", err)
+	}
+
+	return nil
+}
+
+// joinFailed counts the failed attempt to join a game, the user is locked out after too many attempts
+func (m *manager) joinFailed(u userModel.User, chatID int64, text string) error {
+	if m.joinLimiter.Fail(u.ID) {
+		_, err := m.joinLocked(u, chatID)
+		return err
+	}
+
+	if _, err := m.tg.SendText(transport.Message{ChatID: chatID, Text: text}); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// joinLocked tells the user locked out of joining the games when the lockout ends
+func (m *manager) joinLocked(u userModel.User, chatID int64) (bool, error) {
+	left, locked := m.joinLimiter.Locked(u.ID)
+	if !locked {
+		return false, nil
+	}
+
+	m.mtx.Lock()
+	delete(m.commandCbHandlers, u.ID)
+	m.mtx.Unlock()
+
+	lang := resource.Lang(u.LanguageCode)
+	msg := transport.Message{
+		ChatID:   chatID,
+		Text:     fmt.Sprintf(lang.TextJoinLockedMsg, int(math.Ceil(left.Minutes()))),
+		Keyboard: lang.CommonButtons(),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return true, fmt.Errorf("send msg: %w", err)
+	}
+
+	return true, nil
+}
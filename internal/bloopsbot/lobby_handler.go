@@ -0,0 +1,269 @@
+package bloopsbot
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/match"
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+	"github.com/enescakir/emoji"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	lobbyPrefix     = "lobby:"
+	lobbyJoinPrefix = lobbyPrefix + "join:"
+	lobbyQueueData  = lobbyPrefix + "queue"
+)
+
+// queuedUser is the user waiting for the matchmaking to gather the players
+type queuedUser struct {
+	user   userModel.User
+	chatID int64
+	at     time.Time
+}
+
+// handleFindButton lists the public games waiting for the players
+func (m *manager) handleFindButton(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
+	entries := m.lobbyEntries()
+
+	buf := strings.Builder{}
+	markup := tgbotapi.NewInlineKeyboardMarkup()
+	if len(entries) == 0 {
+		buf.WriteString(lang.TextLobbyEmptyMsg)
+	} else {
+		buf.WriteString(lang.TextLobbyTitle)
+	}
+
+	for i, entry := range entries {
+		// the game with no limit of the players shows only the number of the players
+		players := strconv.Itoa(entry.Players)
+		if entry.MaxPlayers > 0 {
+			players += "/" + strconv.Itoa(entry.MaxPlayers)
+		}
+
+		_, _ = fmt.Fprintf(
+			&buf,
+			lang.TextLobbyEntry,
+			i+1,
+			emoji.BustsInSilhouette.String(),
+			players,
+			emoji.HourglassDone.String(),
+			int(time.Since(entry.CreatedAt).Minutes()),
+		)
+		buf.WriteString(entry.Settings)
+		buf.WriteString("\n")
+
+		markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(
+				fmt.Sprintf(lang.TextLobbyJoin, i+1),
+				lobbyJoinPrefix+strconv.FormatInt(entry.Code, 10),
+			),
+		))
+	}
+
+	markup.InlineKeyboard = append(markup.InlineKeyboard, tgbotapi.NewInlineKeyboardRow(
+		tgbotapi.NewInlineKeyboardButtonData(lang.TextQuickMatch, lobbyQueueData),
+	))
+
+	msg := transport.Message{ChatID: chatID, Text: buf.String(), Markdown: true, Keyboard: markup}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// lobbyEntries returns the public games that wait the longest
+func (m *manager) lobbyEntries() []match.LobbyEntry {
+	m.mtx.RLock()
+	sessions := make([]*match.Session, 0, len(m.matchSessions))
+	for _, session := range m.matchSessions {
+		sessions = append(sessions, session)
+	}
+	m.mtx.RUnlock()
+
+	var entries []match.LobbyEntry
+	for _, session := range sessions {
+		if entry, ok := session.LobbyEntry(); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].CreatedAt.Before(entries[j].CreatedAt)
+	})
+
+	if len(entries) > resource.LobbySize {
+		entries = entries[:resource.LobbySize]
+	}
+
+	return entries
+}
+
+// handleLobbyCallback joins the public game chosen in the list or puts the user in the matchmaking queue
+func (m *manager) handleLobbyCallback(u userModel.User, query *tgbotapi.CallbackQuery) error {
+	if query.Message == nil {
+		return nil
+	}
+
+	lang := resource.Lang(u.LanguageCode)
+	chatID := query.Message.Chat.ID
+	_, building := m.userBuildingSession(u.ID)
+	if _, playing := m.userMatchSession(u.ID); playing || building {
+		if err := m.tg.AnswerCallback(query.ID, fmt.Sprintf(lang.TextGroupPlayerInGameMsg, u.FirstName)); err != nil {
+			return fmt.Errorf("send answer msg: %w", err)
+		}
+
+		return nil
+	}
+
+	if query.Data == lobbyQueueData {
+		if err := m.tg.AnswerCallback(query.ID, ""); err != nil {
+			return fmt.Errorf("send answer msg: %w", err)
+		}
+
+		return m.enqueue(u, chatID)
+	}
+
+	code, err := strconv.ParseInt(strings.TrimPrefix(query.Data, lobbyJoinPrefix), 10, 64)
+	if err != nil {
+		return fmt.Errorf("strconv: %w", err)
+	}
+
+	session, ok := m.matchSession(code)
+	if ok {
+		_, ok = session.LobbyEntry()
+	}
+
+	if !ok {
+		if err := m.tg.AnswerCallback(query.ID, lang.TextLobbyGoneMsg); err != nil {
+			return fmt.Errorf("send answer msg: %w", err)
+		}
+
+		return nil
+	}
+
+	if err := m.tg.AnswerCallback(query.ID, ""); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	// the join from the lobby is checked as any other join
+	return m.joinGame(u, chatID, code, false)
+}
+
+// enqueue puts the user in the matchmaking queue or takes them out if they are already there,
+// a new public game is made once enough users are waiting
+func (m *manager) enqueue(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
+	size := m.config.MatchmakingPlayers
+	if size < 2 {
+		size = 2
+	}
+
+	m.mtx.Lock()
+	queue := m.matchQueue[:0]
+	var queued bool
+	for _, q := range m.matchQueue {
+		switch {
+		case q.user.ID == u.ID:
+			queued = true
+		case time.Since(q.at) > m.config.MatchmakingTimeout:
+		case m.userMatchSessions[q.user.ID] != nil || m.userBuildingSessions[q.user.ID] != nil:
+		default:
+			queue = append(queue, q)
+		}
+	}
+
+	if queued {
+		m.matchQueue = queue
+		m.mtx.Unlock()
+
+		msg := transport.Message{ChatID: chatID, Text: lang.TextQueueLeftMsg}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	queue = append(queue, queuedUser{user: u, chatID: chatID, at: time.Now()})
+	var players []queuedUser
+	if len(queue) >= size {
+		players = make([]queuedUser, size)
+		copy(players, queue)
+		queue = queue[size:]
+	}
+
+	m.matchQueue = queue
+	waiting := len(queue)
+	m.mtx.Unlock()
+
+	if players != nil {
+		return m.formMatch(players)
+	}
+
+	msg := transport.Message{ChatID: chatID, Text: fmt.Sprintf(lang.TextQueueJoinedMsg, waiting, size)}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// dequeue takes the user out of the matchmaking queue, must be called under the lock
+func (m *manager) dequeue(userID int64) {
+	for i, q := range m.matchQueue {
+		if q.user.ID == userID {
+			m.matchQueue = append(m.matchQueue[:i], m.matchQueue[i+1:]...)
+			return
+		}
+	}
+}
+
+// formMatch makes a public game with the default settings for the users gathered by the matchmaking,
+// the first of them hosts the game
+func (m *manager) formMatch(players []queuedUser) error {
+	host := players[0]
+	config := match.DefaultConfig(host.user.LanguageCode)
+	config.AuthorID = host.user.ID
+	config.AuthorName = host.user.Username
+	config.Public = true
+	config.MaxPlayers = resource.DefaultMaxPlayers
+
+	code, err := m.codes.Allocate()
+	if err != nil {
+		return fmt.Errorf("allocate code: %w", err)
+	}
+
+	session := match.NewSession(m.gameConfig(config, code))
+	session.Run(m.ctxSess)
+	m.mtx.Lock()
+	m.matchSessions[code] = session
+	m.mtx.Unlock()
+
+	lang := session.Lang()
+	for i, player := range players {
+		text := fmt.Sprintf(lang.TextMatchFoundMsg, host.user.FirstName)
+		if i == 0 {
+			text = lang.TextMatchFoundHostMsg
+		}
+
+		msg := transport.Message{ChatID: player.chatID, Text: text, Markdown: true}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		if err := m.addToGame(player.user, player.chatID, session, false); err != nil {
+			return fmt.Errorf("add to game: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -4,23 +4,33 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"net/http"
 	"runtime"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/builder"
+	"github.com/bloops-games/bloops/internal/bloopsbot/gamecode"
 	"github.com/bloops-games/bloops/internal/bloopsbot/match"
+	"github.com/bloops-games/bloops/internal/bloopsbot/rating"
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	"github.com/bloops-games/bloops/internal/bloopsbot/util"
+	bloopsDb "github.com/bloops-games/bloops/internal/database/bloops/database"
+	builderStateDB "github.com/bloops-games/bloops/internal/database/builderstate/database"
+	builderstateModel "github.com/bloops-games/bloops/internal/database/builderstate/model"
+	gamelogDb "github.com/bloops-games/bloops/internal/database/gamelog/database"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
+	leaderboardDb "github.com/bloops-games/bloops/internal/database/leaderboard/database"
+	leaderboardModel "github.com/bloops-games/bloops/internal/database/leaderboard/model"
 	stateDB "github.com/bloops-games/bloops/internal/database/matchstate/database"
 	matchstateModel "github.com/bloops-games/bloops/internal/database/matchstate/model"
+	packDb "github.com/bloops-games/bloops/internal/database/pack/database"
 	statDb "github.com/bloops-games/bloops/internal/database/stat/database"
 	statModel "github.com/bloops-games/bloops/internal/database/stat/model"
 	userDb "github.com/bloops-games/bloops/internal/database/user/database"
 	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+	"github.com/bloops-games/bloops/internal/dictionary"
 	"github.com/bloops-games/bloops/internal/logging"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 )
@@ -34,23 +44,42 @@ type (
 var ErrTelegramResponseTypeNotFound = fmt.Errorf("telegram response not found")
 
 func NewManager(
-	tg *tgbotapi.BotAPI,
+	tg transport.Transport,
+	updater transport.Updater,
 	config *Config,
 	userDB *userDb.DB,
 	statDB *statDb.DB,
 	stateDB *stateDB.DB,
+	packDB *packDb.DB,
+	bloopsDB *bloopsDb.DB,
+	gameLogDB *gamelogDb.DB,
+	builderStateDB *builderStateDB.DB,
+	leaderboardDB *leaderboardDb.DB,
+	dict dictionary.Dictionary,
 ) *manager {
 	return &manager{
 		tg:                   tg,
+		updater:              updater,
 		config:               config,
 		userBuildingSessions: map[int64]*builder.Session{},
 		userMatchSessions:    map[int64]*match.Session{},
 		matchSessions:        map[int64]*match.Session{},
+		chatBuildingSessions: map[int64]*builder.Session{},
+		chatMatchSessions:    map[int64]*match.Session{},
 		commandCbHandlers:    map[int64]commandCbHandlerFunc{},
 		commandHandlers:      map[string]commandHandler{},
+		groupHandlers:        map[string]commandHandler{},
+		joinRequests:         map[int64]joinRequest{},
+		joinLimiter:          util.NewLimiter(config.JoinAttempts, config.JoinLockout),
 		userDB:               userDB,
 		statDB:               statDB,
 		stateDB:              stateDB,
+		packDB:               packDB,
+		bloopsDB:             bloopsDB,
+		gameLogDB:            gameLogDB,
+		builderStateDB:       builderStateDB,
+		leaderboardDB:        leaderboardDB,
+		dictionary:           dict,
 	}
 }
 
@@ -75,24 +104,48 @@ func (t commandHandler) execute(u userModel.User, chatID int64) error {
 }
 
 type manager struct {
-	tg     *tgbotapi.BotAPI
-	config *Config
+	tg      transport.Transport
+	updater transport.Updater
+	config  *Config
 
 	mtx sync.RWMutex
 	// key: UserID active building session
 	userBuildingSessions map[int64]*builder.Session
 	// key: UserID active playing session
 	userMatchSessions map[int64]*match.Session
-	// key: generated int64 code
+	// key: code given out by the allocator
 	matchSessions map[int64]*match.Session
+	// unique codes of the games
+	codes *gamecode.Allocator
+	// key: UserID waiting for the author of the private game to answer the join request
+	joinRequests map[int64]joinRequest
+	// failed attempts to join a game
+	joinLimiter *util.Limiter
+	// users waiting for the matchmaking in the order they came
+	matchQueue []queuedUser
+	// key: ChatID of the group chat the game is being built in
+	chatBuildingSessions map[int64]*builder.Session
+	// key: ChatID of the group chat the game is played in
+	chatMatchSessions map[int64]*match.Session
 	// command callbacks
 	commandCbHandlers map[int64]commandCbHandlerFunc
 	// command handlers
 	commandHandlers map[string]commandHandler
-
-	userDB     *userDb.DB
-	statDB     *statDb.DB
-	stateDB    *stateDB.DB
+	// group chat command handlers
+	groupHandlers map[string]commandHandler
+
+	userDB         *userDb.DB
+	statDB         *statDb.DB
+	stateDB        *stateDB.DB
+	packDB         *packDb.DB
+	bloopsDB       *bloopsDb.DB
+	gameLogDB      *gamelogDb.DB
+	builderStateDB *builderStateDB.DB
+	leaderboardDB  *leaderboardDb.DB
+	// checks the typed answers, nil if the dictionary is disabled
+	dictionary dictionary.Dictionary
+	// sessions that could not be saved on shutdown
+	unsaved    []string
 	cancel     func()
 	ctxSess    context.Context
 	cancelSess func()
@@ -103,54 +156,13 @@ func (m *manager) Stop() {
 }
 
 func (m *manager) Run(ctx context.Context) error {
-	var updates tgbotapi.UpdatesChannel
 	ctx, cancel := context.WithCancel(ctx)
-	logger := logging.FromContext(ctx)
 	m.cancel = cancel
 	m.ctxSess, m.cancelSess = context.WithCancel(context.Background())
 
-	if m.config.BotWebhookHookURL != "" {
-		_, err := m.tg.SetWebhook(tgbotapi.NewWebhook(m.config.BotWebhookHookURL + m.config.BotToken))
-		if err != nil {
-			return fmt.Errorf("tg bot set webhook: %w", err)
-		}
-
-		info, err := m.tg.GetWebhookInfo()
-		if err != nil {
-			return fmt.Errorf("get webhook info: %w", err)
-		}
-
-		if info.LastErrorDate != 0 {
-			logger.Errorf("Telegram callback failed: %s", info.LastErrorMessage)
-		}
-
-		updates = m.tg.ListenForWebhook("/" + m.config.BotToken)
-		go func() {
-			if err := http.ListenAndServe(m.config.BotWebhookAddr, nil); err != nil {
-				logger.Fatalf("listen and serve http stopped: %v", err)
-				cancel()
-			}
-		}()
-	} else {
-		resp, err := m.tg.RemoveWebhook()
-		if err != nil {
-			return fmt.Errorf("remove webhook: %w", err)
-		}
-
-		if !resp.Ok {
-			if resp.ErrorCode > 0 {
-				return fmt.Errorf("remove webhook with error code %d and description %s", resp.ErrorCode, resp.Description)
-			}
-			return fmt.Errorf("remove webhook response not ok=)")
-		}
-
-		upd := tgbotapi.NewUpdate(0)
-		upd.Timeout = int(m.config.TgBotPollTimeout.Seconds())
-		up, err := m.tg.GetUpdatesChan(upd)
-		if err != nil {
-			return fmt.Errorf("tg get updates chan: %w", err)
-		}
-		updates = up
+	updates, err := m.updater.Updates(ctx)
+	if err != nil {
+		return fmt.Errorf("updates: %w", err)
 	}
 
 	userMiddleware := []commandMiddlewareFunc{m.isActive}
@@ -173,25 +185,40 @@ func (m *manager) Run(ctx context.Context) error {
 		commandHandler{commandFn: m.handleProfileCmd, middlewareFn: userMiddleware},
 	)
 	m.registerCommandHandler(
-		resource.ProfileButtonText,
-		commandHandler{commandFn: m.handleProfileButton, middlewareFn: userMiddleware},
-	)
-	m.registerCommandHandler(
-		resource.CreateButtonText,
-		commandHandler{commandFn: m.handleCreateButton, middlewareFn: userMiddleware},
-	)
-	m.registerCommandHandler(
-		resource.JoinButtonText,
-		commandHandler{commandFn: m.handleJoinButton, middlewareFn: userMiddleware},
-	)
-	m.registerCommandHandler(
-		resource.LeaveButtonText,
-		commandHandler{commandFn: m.handleButtonExit, middlewareFn: userMiddleware},
-	)
-	m.registerCommandHandler(
-		resource.RuleButtonText,
-		commandHandler{commandFn: m.handleRulesButton, middlewareFn: userMiddleware},
+		resource.CmdTop,
+		commandHandler{commandFn: m.handleTopCommand, middlewareFn: userMiddleware},
 	)
+	// button texts differ between the locales, a player presses the buttons of their own language
+	for _, lang := range resource.Locales() {
+		m.registerCommandHandler(
+			lang.ProfileButtonText,
+			commandHandler{commandFn: m.handleProfileButton, middlewareFn: userMiddleware},
+		)
+		m.registerCommandHandler(
+			lang.CreateButtonText,
+			commandHandler{commandFn: m.handleCreateButton, middlewareFn: userMiddleware},
+		)
+		m.registerCommandHandler(
+			lang.JoinButtonText,
+			commandHandler{commandFn: m.handleJoinButton, middlewareFn: userMiddleware},
+		)
+		m.registerCommandHandler(
+			lang.WatchButtonText,
+			commandHandler{commandFn: m.handleWatchButton, middlewareFn: userMiddleware},
+		)
+		m.registerCommandHandler(
+			lang.FindButtonText,
+			commandHandler{commandFn: m.handleFindButton, middlewareFn: userMiddleware},
+		)
+		m.registerCommandHandler(
+			lang.LeaveButtonText,
+			commandHandler{commandFn: m.handleButtonExit, middlewareFn: userMiddleware},
+		)
+		m.registerCommandHandler(
+			lang.RuleButtonText,
+			commandHandler{commandFn: m.handleRulesButton, middlewareFn: userMiddleware},
+		)
+	}
 	m.registerCommandHandler(
 		resource.CmdAddPlayer,
 		commandHandler{commandFn: m.handleRegisterOfflinePlayerCmd, middlewareFn: userMiddleware},
@@ -201,11 +228,49 @@ func (m *manager) Run(ctx context.Context) error {
 		commandHandler{commandFn: m.handleBanCommand, middlewareFn: adminMiddleware},
 	)
 
+	// register group chat command handlers
+	m.registerGroupHandler(
+		resource.CmdGroupGame,
+		commandHandler{commandFn: m.handleGroupGameCommand, middlewareFn: userMiddleware},
+	)
+	m.registerGroupHandler(
+		resource.CmdGroupJoin,
+		commandHandler{commandFn: m.handleGroupJoinCommand, middlewareFn: userMiddleware},
+	)
+	m.registerGroupHandler(
+		resource.CmdGroupWatch,
+		commandHandler{commandFn: m.handleGroupWatchCommand, middlewareFn: userMiddleware},
+	)
+	m.registerGroupHandler(
+		resource.CmdGroupLeave,
+		commandHandler{commandFn: m.handleGroupLeaveCommand, middlewareFn: userMiddleware},
+	)
+	m.registerGroupHandler(
+		resource.CmdRules,
+		commandHandler{commandFn: m.handleRulesButton, middlewareFn: userMiddleware},
+	)
+
+	codes, err := gamecode.NewAllocator(gamecode.Config{
+		Length:   m.config.CodeLength,
+		Alphabet: m.config.CodeAlphabet,
+		Words:    m.config.CodeWords,
+		Expiry:   m.config.CodeExpiry,
+	})
+	if err != nil {
+		return fmt.Errorf("new code allocator: %w", err)
+	}
+
+	m.codes = codes
+
 	// restoreInterruptedGames not completed sessions
 	if err := m.restoreInterruptedGames(); err != nil {
 		return fmt.Errorf("restoreInterruptedGames: %w", err)
 	}
 
+	if err := m.restoreInterruptedBuilders(); err != nil {
+		return fmt.Errorf("restoreInterruptedBuilders: %w", err)
+	}
+
 	wg := &sync.WaitGroup{}
 	poolWorkerNum := runtime.NumCPU()
 	wg.Add(poolWorkerNum)
@@ -215,7 +280,10 @@ func (m *manager) Run(ctx context.Context) error {
 	}
 
 	wg.Wait()
-	m.shutdown()
+	if err := m.shutdown(ctx); err != nil {
+		return fmt.Errorf("shutdown: %w", err)
+	}
+
 	return nil
 }
 
@@ -233,10 +301,10 @@ func (m *manager) pool(ctx context.Context, wg *sync.WaitGroup, updCh tgbotapi.U
 
 			if update.Message != nil {
 				if update.Message.Chat.IsGroup() || update.Message.Chat.IsSuperGroup() {
-					msg := tgbotapi.NewMessage(update.Message.Chat.ID, resource.TextChatNotAllowed)
-					msg.ParseMode = tgbotapi.ModeMarkdown
-					if _, err := m.tg.Send(msg); err != nil {
-						logger.Errorf("send msg: %v", err)
+					if err := m.routeGroup(ctx, u, update); err != nil {
+						if !errors.Is(err, match.ErrValidation) {
+							logger.Errorf("handle group command query: %v", err)
+						}
 					}
 					continue
 				}
@@ -271,6 +339,21 @@ func (m *manager) route(ctx context.Context, u userModel.User, upd tgbotapi.Upda
 		return nil
 	}
 
+	// the deep link of the invite opens the bot with the /start <payload> command
+	if payload := strings.TrimPrefix(upd.Message.Text, resource.CmdStart+" "); payload != upd.Message.Text {
+		handler := commandHandler{
+			commandFn: func(u userModel.User, chatID int64) error {
+				return m.handleStartPayload(u, chatID, payload)
+			},
+			middlewareFn: []commandMiddlewareFunc{m.isActive},
+		}
+		if err := handler.execute(u, upd.Message.Chat.ID); err != nil {
+			return fmt.Errorf("execute start handler: %w", err)
+		}
+
+		return nil
+	}
+
 	if cb, ok := m.commandCbHandler(u.ID); ok {
 		if err := cb(upd.Message.Text); err != nil {
 			return fmt.Errorf("execute cb: %w", err)
@@ -298,6 +381,64 @@ func (m *manager) route(ctx context.Context, u userModel.User, upd tgbotapi.Upda
 	return nil
 }
 
+// routeGroup handles messages from group chats, only group commands and the messages of the game bound
+// to the chat are accepted there
+func (m *manager) routeGroup(ctx context.Context, u userModel.User, upd tgbotapi.Update) error {
+	logger := logging.FromContext(ctx).Named("bloopsbot.manager.routeGroup")
+	chatID := upd.Message.Chat.ID
+	cmd := groupCommand(upd.Message.Text)
+
+	if handler, ok := m.groupHandler(cmd); ok {
+		logger.Infof("Group command received from user %s, command %s", u.FirstName, cmd)
+		if err := handler.execute(u, chatID); err != nil {
+			return fmt.Errorf("execute group command handler: %w", err)
+		}
+
+		return nil
+	}
+
+	if _, ok := m.commandHandler(cmd); ok {
+		msg := transport.Message{ChatID: chatID, Text: resource.Lang(u.LanguageCode).TextChatNotAllowed, Markdown: true}
+		if _, err := m.tg.SendText(msg); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	if session, ok := m.chatBuildingSession(chatID); ok && session.AuthorID == u.ID {
+		if err := session.Execute(upd); err != nil {
+			return fmt.Errorf("execute building session: %w", err)
+		}
+
+		return nil
+	}
+
+	if session, ok := m.chatMatchSession(chatID); ok {
+		if userSession, ok := m.userMatchSession(u.ID); ok && userSession == session {
+			upd.Message.Text = cmd
+			if err := session.Execute(u.ID, upd); err != nil {
+				return fmt.Errorf("execute playing session: %w", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// groupCommand strips the bot username from commands, in group chats they are sent as /cmd@botname
+func groupCommand(text string) string {
+	if !strings.HasPrefix(text, "/") {
+		return text
+	}
+
+	if idx := strings.Index(text, "@"); idx != -1 {
+		return text[:idx]
+	}
+
+	return text
+}
+
 func (m *manager) handleCallbackQuery(ctx context.Context, u userModel.User, upd tgbotapi.Update) error {
 	logger := logging.FromContext(ctx).Named("bloopsbot.manager.handlerCallbackQuery")
 	logger.Infof(
@@ -307,6 +448,30 @@ func (m *manager) handleCallbackQuery(ctx context.Context, u userModel.User, upd
 		upd.CallbackQuery.Data,
 	)
 
+	if strings.HasPrefix(upd.CallbackQuery.Data, joinRequestPrefix) {
+		if err := m.answerJoinRequest(u, upd.CallbackQuery); err != nil {
+			return fmt.Errorf("answer join request: %w", err)
+		}
+
+		return nil
+	}
+
+	if strings.HasPrefix(upd.CallbackQuery.Data, lobbyPrefix) {
+		if err := m.handleLobbyCallback(u, upd.CallbackQuery); err != nil {
+			return fmt.Errorf("handle lobby cb: %w", err)
+		}
+
+		return nil
+	}
+
+	if strings.HasPrefix(upd.CallbackQuery.Data, topPrefix) {
+		if err := m.handleTopCallback(u, upd.CallbackQuery); err != nil {
+			return fmt.Errorf("handle top cb: %w", err)
+		}
+
+		return nil
+	}
+
 	if session, ok := m.userBuildingSession(u.ID); ok {
 		if err := session.Execute(upd); err != nil {
 			return fmt.Errorf("execute building cb: %w", err)
@@ -317,18 +482,42 @@ func (m *manager) handleCallbackQuery(ctx context.Context, u userModel.User, upd
 		if err := session.Execute(u.ID, upd); err != nil {
 			return fmt.Errorf("execute playing cb: %w", err)
 		}
+
+		return nil
+	}
+
+	// buttons of a group game are visible to everyone in the chat
+	if upd.CallbackQuery.Message != nil {
+		if session, ok := m.chatMatchSession(upd.CallbackQuery.Message.Chat.ID); ok {
+			if err := m.tg.AnswerCallback(upd.CallbackQuery.ID, session.Lang().TextNotYourTurnMsg); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+		}
 	}
 
 	return nil
 }
 
+// gameConfig binds the settings of the game with the code to the manager
+func (m *manager) gameConfig(config match.Config, code int64) match.Config {
+	config.Timeout = m.config.PlayingTimeout
+	config.Code = code
+	config.Tg = m.tg
+	config.DoneFn = m.matchDoneFn
+	config.WarnFn = m.matchWarnFn
+	config.LogFn = m.matchLogFn
+	config.CheckpointFn = m.matchCheckpointFn
+	config.Dictionary = m.dictionary
+	config.KickFn = m.matchKickFn
+	config.ExpireFn = m.matchExpireFn
+	config.ShowRating = m.config.RatingNames
+
+	return config
+}
+
+// buildGameConfig returns the config of the game with the settings chosen by the author
 func (m *manager) buildGameConfig(session *builder.Session, code int64) match.Config {
 	config := match.Config{
-		Timeout:    m.config.PlayingTimeout,
-		Code:       code,
-		Tg:         m.tg,
-		DoneFn:     m.matchDoneFn,
-		WarnFn:     m.matchWarnFn,
 		AuthorID:   session.AuthorID,
 		AuthorName: session.AuthorName,
 		RoundsNum:  session.RoundsNum,
@@ -337,6 +526,25 @@ func (m *manager) buildGameConfig(session *builder.Session, code int64) match.Co
 		Categories: []string{},
 		Letters:    []string{},
 		Vote:       session.Vote,
+		Locale:     session.Locale,
+
+		BloopsChance: session.BloopsChance,
+		BloopsRepeat: session.BloopsRepeat,
+		VoteRule:     session.VoteRule,
+		VoteTimeout:  session.VoteTimeout,
+		TypedAnswers: session.TypedAnswers,
+
+		SpectatorsVote: session.SpectatorsVote,
+		Teams:          session.Teams,
+		OfflineVote:    session.OfflineVote,
+		Private:        session.Private,
+		PIN:            session.PIN,
+		Public:         session.Public,
+		MaxPlayers:     session.MaxPlayers,
+	}
+
+	if session.Group {
+		config.ChatID = session.ChatID
 	}
 
 	for _, category := range session.Categories {
@@ -352,17 +560,43 @@ func (m *manager) buildGameConfig(session *builder.Session, code int64) match.Co
 	}
 
 	if session.Bloops {
-		config.Bloopses = make([]resource.Bloops, len(resource.Bloopses))
-		copy(config.Bloopses, resource.Bloopses)
+		for _, bloops := range session.Bloopses {
+			if bloops.Status {
+				config.Bloopses = append(config.Bloopses, bloops.Bloops)
+			}
+		}
 	}
 
-	return config
+	return m.gameConfig(config, code)
 }
 
 func (m *manager) builderWarnFn(session *builder.Session) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 	delete(m.userBuildingSessions, session.AuthorID)
+	if session.Group {
+		delete(m.chatBuildingSessions, session.ChatID)
+	}
+
+	if err := m.builderStateDB.Add(serializeBuilder(session)); err != nil {
+		m.unsaved = append(m.unsaved, fmt.Sprintf("game setting of %s", session.AuthorName))
+		return fmt.Errorf("builder state db add: %w", err)
+	}
+
+	return nil
+}
+
+// builderExpireFn forgets the setting that has run out of the timeout
+func (m *manager) builderExpireFn(session *builder.Session) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	if m.userBuildingSessions[session.AuthorID] == session {
+		delete(m.userBuildingSessions, session.AuthorID)
+	}
+
+	if session.Group && m.chatBuildingSessions[session.ChatID] == session {
+		delete(m.chatBuildingSessions, session.ChatID)
+	}
 
 	return nil
 }
@@ -372,41 +606,65 @@ func (m *manager) builderDoneFn(session *builder.Session) error {
 		m.mtx.Lock()
 		defer m.mtx.Unlock()
 		delete(m.userBuildingSessions, session.AuthorID)
+		if session.Group {
+			delete(m.chatBuildingSessions, session.ChatID)
+		}
 	}()
 
-	code, err := util.GenerateCodeHash()
+	code, err := m.codes.Allocate()
 	if err != nil {
-		return fmt.Errorf("hash: %w", err)
+		return fmt.Errorf("allocate code: %w", err)
 	}
 
-	for {
-		if _, ok := m.matchSession(code); !ok {
-			session := match.NewSession(m.buildGameConfig(session, code))
-			session.Run(m.ctxSess)
-			m.mtx.Lock()
-			m.matchSessions[code] = session
-			m.mtx.Unlock()
-			break
-		}
+	matchSession := match.NewSession(m.buildGameConfig(session, code))
+	matchSession.Run(m.ctxSess)
+	m.mtx.Lock()
+	m.matchSessions[code] = matchSession
+	m.mtx.Unlock()
+
+	if session.Group {
+		return m.groupGameCreated(session, matchSession)
 	}
 
-	msg := tgbotapi.NewMessage(session.ChatID, resource.TextCreationGameCompletedSuccessfulMsg)
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	if _, err := m.tg.Send(msg); err != nil {
+	lang := matchSession.Lang()
+
+	msg := transport.Message{
+		ChatID:   session.ChatID,
+		Text:     lang.TextCreationGameCompletedSuccessfulMsg,
+		Markdown: true,
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
-	if _, err := m.tg.Send(tgbotapi.NewStickerShare(session.ChatID, resource.GenerateSticker(true))); err != nil {
+	if _, err := m.tg.SendSticker(session.ChatID, resource.GenerateSticker(true)); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
-	msg = tgbotapi.NewMessage(session.ChatID, strconv.Itoa(int(code)))
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	msg.ReplyMarkup = resource.CommonButtons
-	if _, err := m.tg.Send(msg); err != nil {
+	msg = transport.Message{
+		ChatID:   session.ChatID,
+		Text:     m.codes.Format(code),
+		Markdown: true,
+		Keyboard: lang.CommonButtons(),
+	}
+	if _, err := m.tg.SendText(msg); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
+	if m.config.BotUsername == "" {
+		return nil
+	}
+
+	link := util.InviteLink(m.config.BotUsername, code)
+	png, err := util.InviteQR(link)
+	if err != nil {
+		return fmt.Errorf("invite qr: %w", err)
+	}
+
+	if _, err := m.tg.SendPhoto(session.ChatID, "invite.png", png, fmt.Sprintf(lang.TextInviteMsg, link)); err != nil {
+		return fmt.Errorf("send photo: %w", err)
+	}
+
 	return nil
 }
 
@@ -414,15 +672,88 @@ func (m *manager) matchWarnFn(session *match.Session) error {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
 
+	for _, player := range session.Players {
+		delete(m.userMatchSessions, player.UserID)
+	}
+
+	delete(m.matchSessions, session.Code)
+	if session.Config.IsGroup() {
+		delete(m.chatMatchSessions, session.Config.ChatID)
+	}
+
 	if err := m.serializeGames(session); err != nil {
+		m.unsaved = append(m.unsaved, fmt.Sprintf("game %d of %s", session.Code, session.Config.AuthorName))
 		return fmt.Errorf("serializeGames match session: %w", err)
 	}
 
+	return nil
+}
+
+// matchLogFn appends the event to the persisted log of the game
+func (m *manager) matchLogFn(session *match.Session, e gamelogModel.Event) error {
+	if err := m.gameLogDB.Append(session.LogID(), e); err != nil {
+		return fmt.Errorf("game log db append: %w", err)
+	}
+
+	return nil
+}
+
+// matchCheckpointFn stores the current state of the game to resume it after a restart
+func (m *manager) matchCheckpointFn(session *match.Session) error {
+	if err := m.serializeGames(session); err != nil {
+		return fmt.Errorf("serializeGames match session: %w", err)
+	}
+
+	return nil
+}
+
+// matchExpireFn forgets the game that ran out of the playing timeout, its code is given back
+// and its checkpoint is removed, so the game is not restored after a restart
+func (m *manager) matchExpireFn(session *match.Session) error {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+
 	for _, player := range session.Players {
-		delete(m.userMatchSessions, player.UserID)
+		if userSession, ok := m.userMatchSessions[player.UserID]; ok && userSession == session {
+			delete(m.userMatchSessions, player.UserID)
+		}
+	}
+
+	if chatSession, ok := m.chatMatchSessions[session.Config.ChatID]; ok && chatSession == session {
+		delete(m.chatMatchSessions, session.Config.ChatID)
 	}
 
 	delete(m.matchSessions, session.Code)
+	m.codes.Release(session.Code)
+
+	if err := m.stateDB.Delete(session.Config.Code); err != nil && !errors.Is(err, stateDB.ErrBucketNotFound) {
+		return fmt.Errorf("state db delete: %w", err)
+	}
+
+	return nil
+}
+
+// matchKickFn detaches the player kicked by the author from the game and brings back the main menu
+func (m *manager) matchKickFn(session *match.Session, player *matchstateModel.Player) error {
+	if player.Offline {
+		return nil
+	}
+
+	m.mtx.Lock()
+	if userSession, ok := m.userMatchSessions[player.UserID]; ok && userSession == session {
+		delete(m.userMatchSessions, player.UserID)
+	}
+	m.mtx.Unlock()
+
+	if session.Config.IsGroup() {
+		return nil
+	}
+
+	lang := session.Lang()
+	msg := transport.Message{ChatID: player.ChatID, Text: lang.TextKickedMsg, Keyboard: lang.CommonButtons()}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
 
 	return nil
 }
@@ -433,11 +764,24 @@ func (m *manager) matchDoneFn(session *match.Session) error {
 	if err := m.appendStat(session); err != nil {
 		return fmt.Errorf("append stat: %w", err)
 	}
+
+	if err := m.appendRating(session); err != nil {
+		return fmt.Errorf("append rating: %w", err)
+	}
+
+	if err := m.stateDB.Delete(session.Config.Code); err != nil && !errors.Is(err, stateDB.ErrBucketNotFound) {
+		return fmt.Errorf("state db delete: %w", err)
+	}
+
 	for _, player := range session.Players {
 		delete(m.userMatchSessions, player.UserID)
 	}
 
 	delete(m.matchSessions, session.Code)
+	m.codes.Release(session.Code)
+	if session.Config.IsGroup() {
+		delete(m.chatMatchSessions, session.Config.ChatID)
+	}
 
 	return nil
 }
@@ -455,6 +799,19 @@ func (m *manager) commandHandler(cmd string) (commandHandler, bool) {
 	return handler, ok
 }
 
+func (m *manager) registerGroupHandler(cmd string, handler commandHandler) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	m.groupHandlers[cmd] = handler
+}
+
+func (m *manager) groupHandler(cmd string) (commandHandler, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	handler, ok := m.groupHandlers[cmd]
+	return handler, ok
+}
+
 func (m *manager) registerCommandCbHandler(userID int64, fn commandCbHandlerFunc) {
 	m.mtx.Lock()
 	defer m.mtx.Unlock()
@@ -474,6 +831,23 @@ func (m *manager) resetUserSessions(userID int64) {
 	delete(m.userBuildingSessions, userID)
 	delete(m.userMatchSessions, userID)
 	delete(m.commandCbHandlers, userID)
+	delete(m.joinRequests, userID)
+	m.dequeue(userID)
+}
+
+// detachUser takes the banned user out of the game and forgets the game setting of the user
+func (m *manager) detachUser(userID int64) {
+	if session, ok := m.userMatchSession(userID); ok {
+		session.RemovePlayer(userID)
+	}
+
+	m.mtx.Lock()
+	if session, ok := m.userBuildingSessions[userID]; ok && session.Group && m.chatBuildingSessions[session.ChatID] == session {
+		delete(m.chatBuildingSessions, session.ChatID)
+	}
+	m.mtx.Unlock()
+
+	m.resetUserSessions(userID)
 }
 
 func (m *manager) userBuildingSession(userID int64) (*builder.Session, bool) {
@@ -500,24 +874,70 @@ func (m *manager) matchSession(code int64) (*match.Session, bool) {
 	return session, ok
 }
 
-func (m *manager) shutdown() {
-	var ss int
-	m.cancelSess()
+func (m *manager) chatBuildingSession(chatID int64) (*builder.Session, bool) {
 	m.mtx.RLock()
-	bn, ms := len(m.userBuildingSessions), len(m.matchSessions)
-	m.mtx.RUnlock()
-	ss = bn + ms
+	defer m.mtx.RUnlock()
+	session, ok := m.chatBuildingSessions[chatID]
+
+	return session, ok
+}
+
+func (m *manager) chatMatchSession(chatID int64) (*match.Session, bool) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	session, ok := m.chatMatchSessions[chatID]
+
+	return session, ok
+}
+
+// shutdown stops the sessions, each of them warns its players and saves itself in the warn function.
+// It waits for the sessions to be saved until the deadline and reports the ones that could not be saved
+func (m *manager) shutdown(ctx context.Context) error {
+	logger := logging.FromContext(ctx).Named("manager.shutdown")
+	m.cancelSess()
+
+	deadline := time.NewTimer(m.config.ShutdownTimeout)
+	defer deadline.Stop()
 	ticker := time.NewTicker(200 * time.Millisecond)
-	for ss > 0 {
+	defer ticker.Stop()
+
+Drain:
+	for {
+		m.mtx.RLock()
+		bn, ms := len(m.userBuildingSessions), len(m.matchSessions)
+		m.mtx.RUnlock()
+		if bn+ms == 0 {
+			break
+		}
+
 		select {
 		case <-ticker.C:
-			m.mtx.RLock()
-			bn, ms := len(m.userBuildingSessions), len(m.matchSessions)
-			m.mtx.RUnlock()
-			ss = bn + ms
-		default:
+		case <-deadline.C:
+			m.mtx.Lock()
+			for _, session := range m.userBuildingSessions {
+				m.unsaved = append(m.unsaved, fmt.Sprintf("game setting of %s", session.AuthorName))
+			}
+			for _, session := range m.matchSessions {
+				m.unsaved = append(m.unsaved, fmt.Sprintf("game %d of %s", session.Code, session.Config.AuthorName))
+			}
+			m.mtx.Unlock()
+			break Drain
+		}
+	}
+
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	if len(m.unsaved) > 0 {
+		for _, name := range m.unsaved {
+			logger.Errorf("Session is not saved: %s", name)
 		}
+
+		return fmt.Errorf("%d sessions are not saved: %s", len(m.unsaved), strings.Join(m.unsaved, ", "))
 	}
+
+	logger.Infof("All sessions are saved")
+
+	return nil
 }
 
 func (m *manager) recvUser(upd tgbotapi.Update) (userModel.User, error) {
@@ -572,9 +992,10 @@ func (m *manager) recvUser(upd tgbotapi.Update) (userModel.User, error) {
 
 func NewMatchSessionFromSerialized(
 	ser matchstateModel.State,
-	tg *tgbotapi.BotAPI,
+	tg transport.Transport,
 	doneFn func(session *match.Session) error,
 	warnFn func(session *match.Session) error,
+	logFn func(session *match.Session, e gamelogModel.Event) error,
 ) *match.Session {
 	c := match.Config{
 		AuthorID:   ser.AuthorID,
@@ -586,10 +1007,30 @@ func NewMatchSessionFromSerialized(
 		Bloopses:   make([]resource.Bloops, len(ser.Bloopses)),
 		Vote:       ser.Vote,
 		Code:       ser.Code,
+		ChatID:     ser.ChatID,
+		Locale:     ser.Locale,
 		Timeout:    ser.Timeout,
 		Tg:         tg,
 		DoneFn:     doneFn,
 		WarnFn:     warnFn,
+		LogFn:      logFn,
+
+		BloopsChance: ser.BloopsChance,
+		BloopsRepeat: ser.BloopsRepeat,
+		Seed:         ser.Seed,
+		Draws:        ser.Draws,
+		Resumed:      true,
+		VoteRule:     ser.VoteRule,
+		VoteTimeout:  ser.VoteTimeout,
+		TypedAnswers: ser.TypedAnswers,
+
+		SpectatorsVote: ser.SpectatorsVote,
+		Teams:          ser.Teams,
+		OfflineVote:    ser.OfflineVote,
+		Private:        ser.Private,
+		PIN:            ser.PIN,
+		Public:         ser.Public,
+		MaxPlayers:     ser.MaxPlayers,
 	}
 
 	copy(c.Categories, ser.Categories)
@@ -599,34 +1040,19 @@ func NewMatchSessionFromSerialized(
 	s := match.NewSession(c)
 	s.State = ser.State
 	s.CurrRoundIdx = ser.CurrRoundIdx
+	s.CurrPlayerIdx = ser.CurrPlayerIdx
+	// the states stored before the creation time was kept restart the timeout
+	if !ser.CreatedAt.IsZero() {
+		s.CreatedAt = ser.CreatedAt
+		s.PausedFor = ser.PausedFor
+	}
 	s.Players = make([]*matchstateModel.Player, len(ser.Players))
 	copy(s.Players, ser.Players)
 	return s
 }
 
 func (m *manager) serializeGames(session *match.Session) error {
-	s := matchstateModel.State{
-		Timeout:      session.Config.Timeout,
-		AuthorID:     session.Config.AuthorID,
-		AuthorName:   session.Config.AuthorName,
-		RoundsNum:    session.Config.RoundsNum,
-		RoundTime:    session.Config.RoundTime,
-		Vote:         session.Config.Vote,
-		Code:         session.Config.Code,
-		State:        session.State,
-		CurrRoundIdx: session.CurrRoundIdx,
-		CreatedAt:    session.CreatedAt,
-		Categories:   make([]string, len(session.Config.Categories)),
-		Letters:      make([]string, len(session.Config.Letters)),
-		Bloopses:     make([]resource.Bloops, len(session.Config.Bloopses)),
-		Players:      make([]*matchstateModel.Player, len(session.Players)),
-	}
-
-	copy(s.Categories, session.Config.Categories)
-	copy(s.Letters, session.Config.Letters)
-	copy(s.Bloopses, session.Config.Bloopses)
-	copy(s.Players, session.Players)
-
+	s := session.Snapshot()
 	if err := m.stateDB.Add(s); err != nil {
 		return fmt.Errorf("state db add: %w", err)
 	}
@@ -642,9 +1068,29 @@ func (m *manager) restoreInterruptedGames() error {
 
 	m.mtx.Lock()
 	for _, state := range states {
-		session := NewMatchSessionFromSerialized(state, m.tg, m.matchDoneFn, m.matchWarnFn)
+		// the checkpoints of the games that have run out of time are not resumed
+		if time.Since(state.CreatedAt)-state.PausedFor > state.Timeout {
+			if err := m.stateDB.Delete(state.Code); err != nil {
+				m.mtx.Unlock()
+				return fmt.Errorf("state db delete: %w", err)
+			}
+
+			continue
+		}
+
+		session := NewMatchSessionFromSerialized(state, m.tg, m.matchDoneFn, m.matchWarnFn, m.matchLogFn)
+		session.Config.CheckpointFn = m.matchCheckpointFn
+		session.Config.Dictionary = m.dictionary
+		session.Config.KickFn = m.matchKickFn
+		session.Config.ExpireFn = m.matchExpireFn
+		session.Config.ShowRating = m.config.RatingNames
 		session.Run(m.ctxSess)
+		session.NotifyResumed()
 		m.matchSessions[session.Config.Code] = session
+		m.codes.Reserve(session.Config.Code)
+		if session.Config.IsGroup() {
+			m.chatMatchSessions[session.Config.ChatID] = session
+		}
 		for _, player := range session.Players {
 			if !player.Offline {
 				m.userMatchSessions[player.UserID] = session
@@ -658,10 +1104,120 @@ func (m *manager) restoreInterruptedGames() error {
 
 	m.mtx.Unlock()
 
+	return nil
+}
+
+func serializeBuilder(session *builder.Session) builderstateModel.State {
+	s := builderstateModel.State{
+		AuthorID:     session.AuthorID,
+		AuthorName:   session.AuthorName,
+		ChatID:       session.ChatID,
+		Group:        session.Group,
+		Locale:       session.Locale,
+		Stage:        session.Stage(),
+		RoundsNum:    session.RoundsNum,
+		RoundTime:    session.RoundTime,
+		Vote:         session.Vote,
+		Bloops:       session.Bloops,
+		BloopsChance: session.BloopsChance,
+		BloopsRepeat: session.BloopsRepeat,
+		PackCode:     session.PackCode,
+		VoteRule:     session.VoteRule,
+		VoteTimeout:  session.VoteTimeout,
+		TypedAnswers: session.TypedAnswers,
+		CreatedAt:    session.CreatedAt,
+		Categories:   make([]resource.Category, len(session.Categories)),
+		Letters:      make([]resource.Letter, len(session.Letters)),
+		Bloopses:     make([]builderstateModel.BloopsOption, len(session.Bloopses)),
+
+		SpectatorsVote: session.SpectatorsVote,
+		Teams:          session.Teams,
+		OfflineVote:    session.OfflineVote,
+		Private:        session.Private,
+		PIN:            session.PIN,
+		Public:         session.Public,
+		MaxPlayers:     session.MaxPlayers,
+	}
+
+	copy(s.Categories, session.Categories)
+	copy(s.Letters, session.Letters)
+	for i, bloops := range session.Bloopses {
+		s.Bloopses[i] = builderstateModel.BloopsOption(bloops)
+	}
+
+	return s
+}
+
+// restoreInterruptedBuilders resumes the game settings saved on shutdown
+func (m *manager) restoreInterruptedBuilders() error {
+	states, err := m.builderStateDB.FetchAll()
+	if err != nil && !errors.Is(err, builderStateDB.ErrEntryNotFound) {
+		return fmt.Errorf("builder state db fetch all: %w", err)
+	}
+
+	for _, state := range states {
+		// the setting has no time left
+		if time.Since(state.CreatedAt) >= m.config.BuildingTimeout {
+			continue
+		}
+
+		session, err := builder.NewSession(
+			m.tg,
+			state.ChatID,
+			state.AuthorID,
+			state.AuthorName,
+			state.Locale,
+			m.packDB,
+			m.bloopsDB,
+			m.builderDoneFn,
+			m.builderWarnFn,
+			m.builderExpireFn,
+			m.config.BuildingTimeout,
+		)
+		if err != nil {
+			return fmt.Errorf("new builder session: %w", err)
+		}
+
+		session.Group = state.Group
+		session.Categories = state.Categories
+		session.Letters = state.Letters
+		session.RoundsNum = state.RoundsNum
+		session.RoundTime = state.RoundTime
+		session.Vote = state.Vote
+		session.Bloops = state.Bloops
+		session.BloopsChance = state.BloopsChance
+		session.BloopsRepeat = state.BloopsRepeat
+		session.PackCode = state.PackCode
+		session.VoteRule = state.VoteRule
+		session.VoteTimeout = state.VoteTimeout
+		session.TypedAnswers = state.TypedAnswers
+		session.SpectatorsVote = state.SpectatorsVote
+		session.OfflineVote = state.OfflineVote
+		session.Private = state.Private
+		session.PIN = state.PIN
+		session.Public = state.Public
+		session.MaxPlayers = state.MaxPlayers
+		session.Teams = state.Teams
+		session.CreatedAt = state.CreatedAt
+		session.Bloopses = make([]builder.BloopsOption, len(state.Bloopses))
+		for i, bloops := range state.Bloopses {
+			session.Bloopses[i] = builder.BloopsOption(bloops)
+		}
+		session.SeekStage(state.Stage)
+
+		m.mtx.Lock()
+		m.userBuildingSessions[session.AuthorID] = session
+		if session.Group {
+			m.chatBuildingSessions[session.ChatID] = session
+		}
+		session.Run(m.ctxSess)
+		m.mtx.Unlock()
+	}
+
 	if len(states) > 0 {
-		if err := m.stateDB.Clean(); err != nil {
-			if !errors.Is(err, stateDB.ErrBucketNotFound) {
-				return fmt.Errorf("state db clean: %w", err)
+		if err := m.builderStateDB.Clean(); err != nil {
+			if !errors.Is(err, builderStateDB.ErrBucketNotFound) {
+				return fmt.Errorf("builder state db clean: %w", err)
 			}
 		}
 	}
@@ -672,10 +1228,18 @@ func (m *manager) restoreInterruptedGames() error {
 func (m *manager) appendStat(session *match.Session) error {
 	favorites := session.Favorites()
 	stats := make([]statModel.Stat, 0)
+	scores := make([]leaderboardModel.Score, 0)
+
+	var playersNum int
+	for _, player := range session.Players {
+		if !player.Spectator {
+			playersNum++
+		}
+	}
 
 	for _, player := range session.Players {
 		stat := statModel.NewStat(player.UserID)
-		if player.Offline {
+		if player.Offline || player.Spectator {
 			continue
 		}
 
@@ -689,13 +1253,15 @@ func (m *manager) appendStat(session *match.Session) error {
 		copy(stat.Categories, session.Config.Categories)
 
 		stat.RoundsNum = session.Config.RoundsNum
-		stat.PlayersNum = len(session.Players)
+		stat.PlayersNum = playersNum
 
 		var (
 			bestDuration, worstDuration time.Duration = 2 << 31, 0
 			sumDuration, durationNum    time.Duration = 0, 0
 			bestPoints, worstPoints                   = 0, 2 << 28
 			sumPoints, pointsNum                      = 0, 0
+			// the best time of the leaderboard is taken only from the completed rounds
+			bestCompleted time.Duration
 		)
 
 		for _, rate := range player.Rates {
@@ -707,6 +1273,9 @@ func (m *manager) appendStat(session *match.Session) error {
 				if rate.Duration > worstDuration {
 					worstDuration = rate.Duration
 				}
+				if rate.Completed && (bestCompleted == 0 || rate.Duration < bestCompleted) {
+					bestCompleted = rate.Duration
+				}
 				sumDuration += rate.Duration
 			} else {
 				stat.Bloops = append(stat.Bloops, rate.BloopsName)
@@ -739,6 +1308,21 @@ func (m *manager) appendStat(session *match.Session) error {
 
 		stat.SumDuration = sumDuration
 		stats = append(stats, stat)
+
+		score := leaderboardModel.Score{
+			UserID:    player.UserID,
+			FirstName: player.User.FirstName,
+			Username:  player.User.Username,
+			Points:    sumPoints,
+			Bloops:    stat.Bloops,
+			BestTime:  bestCompleted,
+		}
+
+		if stat.Conclusion == statModel.StatusFavorite {
+			score.Stars = 1
+		}
+
+		scores = append(scores, score)
 	}
 
 	for _, stat := range stats {
@@ -747,5 +1331,76 @@ func (m *manager) appendStat(session *match.Session) error {
 		}
 	}
 
+	now := time.Now()
+	for _, score := range scores {
+		if err := m.leaderboardDB.Add(score, now); err != nil {
+			return fmt.Errorf("leaderboard db add: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// appendRating rates the players by their places in the game, the offline players are not rated
+// and the games with fewer players than the minimum are not rated at all
+func (m *manager) appendRating(session *match.Session) error {
+	var scores []match.PlayerScore
+	for _, score := range session.Scores() {
+		if !score.Player.Offline {
+			scores = append(scores, score)
+		}
+	}
+
+	if len(scores) < 2 || len(scores) < m.config.RatingMinPlayers {
+		return nil
+	}
+
+	users := make([]userModel.User, len(scores))
+	ratings := make([]rating.Rating, len(scores))
+	places := make([]int, len(scores))
+	for i, score := range scores {
+		u, err := m.userDB.Fetch(score.Player.UserID)
+		if err != nil {
+			return fmt.Errorf("user db fetch: %w", err)
+		}
+
+		users[i] = u
+		ratings[i] = rating.Rating{
+			Value:      u.Rating.Value,
+			Deviation:  u.Rating.Deviation,
+			Volatility: u.Rating.Volatility,
+		}
+
+		// the scores are sorted by the points, the equal points share the place
+		places[i] = i
+		if i > 0 && score.Points == scores[i-1].Points {
+			places[i] = places[i-1]
+		}
+	}
+
+	now := time.Now()
+	for i, r := range rating.Rate(ratings, places) {
+		u := users[i]
+		u.Rating.Value = r.Value
+		u.Rating.Deviation = r.Deviation
+		u.Rating.Volatility = r.Volatility
+		u.Rating.Games++
+		u.Rating.History = append(u.Rating.History, userModel.RatingChange{
+			At:        now,
+			Value:     r.Value,
+			Deviation: r.Deviation,
+			Place:     places[i] + 1,
+			Players:   len(scores),
+		})
+
+		if len(u.Rating.History) > resource.RatingHistorySize {
+			u.Rating.History = u.Rating.History[len(u.Rating.History)-resource.RatingHistorySize:]
+		}
+
+		if err := m.userDB.Store(u); err != nil {
+			return fmt.Errorf("user db store: %w", err)
+		}
+	}
+
 	return nil
 }
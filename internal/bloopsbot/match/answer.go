@@ -0,0 +1,229 @@
+package match
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+)
+
+var (
+	ErrAnswerLetter    = fmt.Errorf("answer starts with another letter")
+	ErrAnswerDuplicate = fmt.Errorf("answer has already been named")
+)
+
+// turnAnswers are the answers typed by the active player during the timer
+type turnAnswers struct {
+	player *model.Player
+	letter string
+	// words by the category index, empty for the categories without an answer
+	words []string
+}
+
+func (t *turnAnswers) nextFree() int {
+	for i, word := range t.words {
+		if word == "" {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// normalizeAnswer lowercases the word and collapses the spaces to compare the answers
+func normalizeAnswer(word string) string {
+	return strings.ToLower(strings.Join(strings.Fields(word), " "))
+}
+
+// validateAnswer checks that the word starts with the letter and has not been named in the game
+func validateAnswer(word, letter string, used map[string]struct{}) error {
+	word = normalizeAnswer(word)
+	if !strings.HasPrefix(word, strings.ToLower(letter)) {
+		return ErrAnswerLetter
+	}
+
+	if _, ok := used[word]; ok {
+		return ErrAnswerDuplicate
+	}
+
+	return nil
+}
+
+// parseAnswerLine splits the line into the category index and the word, the index is -1
+// if the line does not start with the number of the category
+func parseAnswerLine(line string, categoriesNum int) (int, string) {
+	line = strings.TrimSpace(line)
+	end := strings.IndexFunc(line, func(r rune) bool {
+		return !unicode.IsDigit(r)
+	})
+	if end <= 0 {
+		return -1, line
+	}
+
+	n, err := strconv.Atoi(line[:end])
+	if err != nil || n < 1 || n > categoriesNum {
+		return -1, line
+	}
+
+	return n - 1, strings.TrimSpace(strings.TrimLeft(line[end:], ".) "))
+}
+
+// startAnswers begins capturing the answers of the player to the letter
+func (r *Session) startAnswers(player *model.Player, letter string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.turnAnswers = &turnAnswers{player: player, letter: letter, words: make([]string, len(r.Config.Categories))}
+}
+
+// stopAnswers stops capturing the answers and returns the accepted ones
+func (r *Session) stopAnswers() []model.Answer {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	var answers []model.Answer
+	if r.turnAnswers != nil {
+		for i, word := range r.turnAnswers.words {
+			if word != "" {
+				answers = append(answers, model.Answer{Category: r.Config.Categories[i], Word: word})
+			}
+		}
+	}
+
+	r.turnAnswers = nil
+
+	return answers
+}
+
+// usedAnswers returns the words named in the game, must be called under the lock
+func (r *Session) usedAnswers() map[string]struct{} {
+	used := map[string]struct{}{}
+	for _, player := range r.Players {
+		for _, rate := range player.Rates {
+			for _, answer := range rate.Answers {
+				used[normalizeAnswer(answer.Word)] = struct{}{}
+			}
+		}
+	}
+
+	if r.turnAnswers != nil {
+		for _, word := range r.turnAnswers.words {
+			if word != "" {
+				used[normalizeAnswer(word)] = struct{}{}
+			}
+		}
+	}
+
+	return used
+}
+
+// captureAnswers accepts the lines of the text as the answers of the active player, the timer stops
+// once every category has an answer. Returns false if the text is not an answer
+func (r *Session) captureAnswers(userID int64, text string) (bool, error) {
+	r.mtx.Lock()
+	answers := r.turnAnswers
+	if answers == nil || answers.player.UserID != userID {
+		r.mtx.Unlock()
+		return false, nil
+	}
+
+	var replies []string
+	for _, line := range strings.Split(text, "\n") {
+		idx, word := parseAnswerLine(line, len(answers.words))
+		if word == "" {
+			continue
+		}
+
+		if idx < 0 {
+			if idx = answers.nextFree(); idx < 0 {
+				break
+			}
+		}
+
+		if err := validateAnswer(word, answers.letter, r.usedAnswers()); err != nil {
+			switch {
+			case errors.Is(err, ErrAnswerLetter):
+				replies = append(replies, fmt.Sprintf(r.lang.TextAnswerLetterMsg, word, answers.letter))
+			case errors.Is(err, ErrAnswerDuplicate):
+				replies = append(replies, fmt.Sprintf(r.lang.TextAnswerDuplicateMsg, word))
+			}
+
+			continue
+		}
+
+		answers.words[idx] = word
+		replies = append(replies, fmt.Sprintf(r.lang.TextAnswerAcceptedMsg, r.Config.Categories[idx], word))
+	}
+
+	// the timer is stopped under the lock while the turn is still active, so the stop can't outlive the turn
+	if answers.nextFree() < 0 {
+		select {
+		case r.stopCh <- struct{}{}:
+		default:
+		}
+	}
+
+	chatID := answers.player.ChatID
+	r.mtx.Unlock()
+
+	if len(replies) > 0 {
+		if _, err := r.tg.SendText(transport.Message{ChatID: chatID, Text: strings.Join(replies, "\n")}); err != nil {
+			return true, fmt.Errorf("send msg: %w", err)
+		}
+	}
+
+	return true, nil
+}
+
+// isButtonText reports whether the text is a command or a menu button rather than an answer
+func (r *Session) isButtonText(text string) bool {
+	return strings.HasPrefix(text, "/") ||
+		text == r.lang.RatingButtonText ||
+		text == r.lang.GameSettingButtonText ||
+		text == r.lang.LeaveButtonText ||
+		text == r.lang.RuleButtonText ||
+		text == r.lang.ManageButtonText
+}
+
+// lookupAnswers marks the answers known by the dictionary, they are accepted without the vote
+func (r *Session) lookupAnswers(answers []model.Answer) error {
+	if r.Config.Dictionary == nil {
+		return nil
+	}
+
+	for i := range answers {
+		known, err := r.Config.Dictionary.Known(r.Config.Locale, answers[i].Category, answers[i].Word)
+		if err != nil {
+			return fmt.Errorf("dictionary known: %w", err)
+		}
+
+		answers[i].Known = known
+	}
+
+	return nil
+}
+
+func acceptedAnswersNum(answers []model.Answer) int {
+	var n int
+	for _, answer := range answers {
+		if !answer.Rejected {
+			n++
+		}
+	}
+
+	return n
+}
+
+func knownAnswersNum(answers []model.Answer) int {
+	var n int
+	for _, answer := range answers {
+		if answer.Known {
+			n++
+		}
+	}
+
+	return n
+}
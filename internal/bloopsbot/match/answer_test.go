@@ -0,0 +1,111 @@
+package match
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+)
+
+func TestParseAnswerLine(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		line        string
+		expectedIdx int
+		expected    string
+	}{
+		{name: "word", line: " apple ", expectedIdx: -1, expected: "apple"},
+		{name: "numbered", line: "2. apple", expectedIdx: 1, expected: "apple"},
+		{name: "numbered_bracket", line: "1) apple pie", expectedIdx: 0, expected: "apple pie"},
+		{name: "out_of_range", line: "5 apple", expectedIdx: -1, expected: "5 apple"},
+		{name: "empty", line: "  ", expectedIdx: -1, expected: ""},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			idx, word := parseAnswerLine(tc.line, 3)
+			if idx != tc.expectedIdx || word != tc.expected {
+				t.Errorf("got %d %q, expected %d %q", idx, word, tc.expectedIdx, tc.expected)
+			}
+		})
+	}
+}
+
+func TestValidateAnswer(t *testing.T) {
+	t.Parallel()
+
+	used := map[string]struct{}{"apple": {}}
+	testCases := []struct {
+		name     string
+		word     string
+		letter   string
+		expected error
+	}{
+		{name: "valid", word: "Avocado", letter: "A", expected: nil},
+		{name: "another_letter", word: "banana", letter: "A", expected: ErrAnswerLetter},
+		{name: "duplicate", word: "  APPLE ", letter: "A", expected: ErrAnswerDuplicate},
+		{name: "cyrillic", word: "Арбуз", letter: "А", expected: nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			if err := validateAnswer(tc.word, tc.letter, used); !errors.Is(err, tc.expected) {
+				t.Errorf("got %v, expected %v", err, tc.expected)
+			}
+		})
+	}
+}
+
+func TestRejectAnswers(t *testing.T) {
+	t.Parallel()
+
+	session := newTestSession(transport.NewRecorder(), 1, 2, 3)
+	session.activeVote = newVote(session.Players[0])
+	session.activeVote.answers = []model.Answer{
+		{Category: "fruit", Word: "apple", Known: true},
+		{Category: "city", Word: "amsterdam"},
+		{Category: "animal", Word: "axolotl"},
+	}
+
+	if _, ok := session.rejectAnswer(2, 0); ok {
+		t.Error("the known answer must not be rejected")
+	}
+
+	if _, ok := session.rejectAnswer(1, 1); ok {
+		t.Error("the player must not vote on the own answers")
+	}
+
+	session.castBallot(2, model.BallotThumbUp)
+	session.castBallot(3, model.BallotThumbUp)
+	session.rejectAnswer(2, 1)
+	session.rejectAnswer(3, 1)
+	// the rejection is taken back by the second press
+	session.rejectAnswer(3, 2)
+	if rejected, ok := session.rejectAnswer(3, 2); !ok || rejected {
+		t.Errorf("expected the rejection taken back, got rejected %t, ok %t", rejected, ok)
+	}
+
+	if n := session.activeVote.countRejects(1); n != 2 {
+		t.Errorf("expected 2 rejects, got %d", n)
+	}
+
+	if n := session.activeVote.resolveAnswers(resource.VoteRuleMajority); n != 2 {
+		t.Fatalf("expected 2 accepted answers, got %d", n)
+	}
+
+	for i, expected := range []bool{false, true, false} {
+		if answer := session.activeVote.answers[i]; answer.Rejected != expected {
+			t.Errorf("answer %s: expected rejected %t, got %t", answer.Word, expected, answer.Rejected)
+		}
+	}
+}
@@ -4,7 +4,10 @@ import (
 	"time"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
-	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	"github.com/bloops-games/bloops/internal/dictionary"
 )
 
 type Config struct {
@@ -17,16 +20,100 @@ type Config struct {
 	Bloopses   []resource.Bloops `json:"bloopses"`
 	Vote       bool              `json:"vote"`
 	Code       int64             `json:"code"`
+	Locale     string            `json:"locale"`
+	// group chat the game is bound to, zero for games in private chats
+	ChatID int64 `json:"chatId"`
+	// chance in percent that a bloops drops before a turn
+	BloopsChance int `json:"bloopsChance"`
+	// the same bloops may drop more than once in the game
+	BloopsRepeat bool `json:"bloopsRepeat"`
+	// seed of the game randomness, the same seed gives the same letters and bloopses
+	Seed int64 `json:"seed"`
+	// values drawn from the seed before the restart, the resumed game continues the sequence
+	Draws int64 `json:"draws"`
+	// the game is restored after a restart
+	Resumed bool `json:"-"`
+	// how the ballots change the points of the round
+	VoteRule resource.VoteRule `json:"voteRule"`
+	// time in seconds for the players to vote
+	VoteTimeout int `json:"voteTimeout"`
+	// the player types the answers in the chat and gets the points for the accepted ones
+	TypedAnswers bool `json:"typedAnswers"`
+	// the spectators vote along with the players
+	SpectatorsVote bool `json:"spectatorsVote"`
+	// the offline players vote through their host along with the players
+	OfflineVote bool `json:"offlineVote"`
+	// names of the teams, the players play for themselves if empty
+	Teams []string `json:"teams"`
+	// the author approves the join requests
+	Private bool `json:"private"`
+	// the players type the PIN before the join request is sent to the author
+	PIN string `json:"pin"`
+	// the game is listed in the public games while it is waiting for the players
+	Public bool `json:"public"`
+	// most players of the game, not limited if zero
+	MaxPlayers int `json:"maxPlayers"`
 
 	State        uint8 `json:"state"`
 	CurrRoundIdx int   `json:"currRoundIdx"`
 
-	Tg      *tgbotapi.BotAPI             `json:"-"`
-	DoneFn  func(session *Session) error `json:"-"`
-	WarnFn  func(session *Session) error `json:"-"`
-	Timeout time.Duration                `json:"-"`
+	Tg      transport.Transport                                `json:"-"`
+	Rand    Rand                                               `json:"-"`
+	LogFn   func(session *Session, e gamelogModel.Event) error `json:"-"`
+	DoneFn  func(session *Session) error                       `json:"-"`
+	WarnFn  func(session *Session) error                       `json:"-"`
+	Timeout time.Duration                                      `json:"-"`
+	// stores the state of the game after every turn to resume it after a crash
+	CheckpointFn func(session *Session) error `json:"-"`
+	// accepts the known typed answers without the vote, nil if there is no dictionary
+	Dictionary dictionary.Dictionary `json:"-"`
+	// detaches the player kicked by the author from the game
+	KickFn func(session *Session, player *model.Player) error `json:"-"`
+	// cleans up after the game that ran out of the playing timeout
+	ExpireFn func(session *Session) error `json:"-"`
+	// shows the ratings of the players next to their names
+	ShowRating bool `json:"-"`
 }
 
 func (c Config) IsBloops() bool {
 	return len(c.Bloopses) > 0
 }
+
+func (c Config) IsTeams() bool {
+	return len(c.Teams) > 0
+}
+
+func (c Config) IsGroup() bool {
+	return c.ChatID != 0
+}
+
+// DefaultConfig returns the settings of the game not changed by the author: the enabled categories and letters
+// of the locale, no bloopses and no vote
+func DefaultConfig(locale string) Config {
+	lang := resource.Lang(locale)
+	config := Config{
+		RoundsNum:    resource.DefaultRoundsNum,
+		RoundTime:    resource.DefaultRoundTime,
+		Categories:   []string{},
+		Letters:      []string{},
+		Bloopses:     []resource.Bloops{},
+		Locale:       lang.Locale,
+		BloopsChance: resource.DefaultBloopsChance,
+		VoteRule:     resource.DefaultVoteRule,
+		VoteTimeout:  resource.DefaultVoteTimeout,
+	}
+
+	for _, category := range lang.Categories {
+		if category.Status {
+			config.Categories = append(config.Categories, category.Text)
+		}
+	}
+
+	for _, letter := range lang.Letters {
+		if letter.Status {
+			config.Letters = append(config.Letters, letter.Text)
+		}
+	}
+
+	return config
+}
@@ -0,0 +1,197 @@
+package match
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	"github.com/bloops-games/bloops/internal/logging"
+)
+
+// LogID identifies the game log, game codes are reused, so the seed is a part of it.
+// The restored game keeps its log and marks the checkpoint it continues from by the resume event
+func (r *Session) LogID() string {
+	return fmt.Sprintf("%d-%d", r.Config.Code, r.Config.Seed)
+}
+
+// resumeEvent marks the checkpoint the restored game continues from
+func (r *Session) resumeEvent() gamelogModel.Event {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	e := gamelogModel.Event{Kind: gamelogModel.EventKindResume, Draws: r.Config.Draws, Rates: make([]int, len(r.Players))}
+	for i, player := range r.Players {
+		e.Rates[i] = len(player.Rates)
+	}
+
+	return e
+}
+
+// logEvent appends the event to the game log, must not be called under the lock
+func (r *Session) logEvent(e gamelogModel.Event) {
+	if r.Config.LogFn == nil {
+		return
+	}
+
+	r.mtx.RLock()
+	e.Round = r.CurrRoundIdx
+	r.mtx.RUnlock()
+	e.At = time.Now()
+
+	if err := r.Config.LogFn(r, e); err != nil {
+		logging.DefaultLogger().Named("match.logEvent").Errorf("append %s event: %v", e.Kind, err)
+	}
+}
+
+// playerIdx returns the index of the player in the game, offline players share the user id with their host
+func (r *Session) playerIdx(player *model.Player) int {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	for i, p := range r.Players {
+		if p == player {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (r *Session) logPlayerEvent(kind gamelogModel.EventKind, player *model.Player, e gamelogModel.Event) {
+	e.Kind = kind
+	e.Player = r.playerIdx(player)
+	e.UserID = player.UserID
+	r.logEvent(e)
+}
+
+// Replay re-derives the players and their rates from the game log, the scores of the returned session
+// are the scores of the logged game
+func Replay(events []gamelogModel.Event) (*Session, error) {
+	if len(events) == 0 || events[0].Kind != gamelogModel.EventKindStart {
+		return nil, fmt.Errorf("game log should begin with the start event")
+	}
+
+	start := events[0]
+	r := NewSession(Config{
+		Seed:      start.Seed,
+		Locale:    start.Locale,
+		RoundsNum: start.RoundsNum,
+		VoteRule:  resource.VoteRule(start.VoteRule),
+
+		TypedAnswers: start.TypedAnswers,
+		Teams:        start.Teams,
+	})
+
+	// bloopses dropped to the players in the current turn by the player index
+	dropped := map[int]gamelogModel.Event{}
+	// rates of the current turn by the player index
+	rates := map[int]*model.Rate{}
+	// points for the typed answers of the current turn, the vote keeps the points of the accepted ones
+	answered := map[int]int{}
+
+	for _, e := range events[1:] {
+		if e.Kind == gamelogModel.EventKindStart {
+			// the game has been resumed after a restart before the resume events were logged
+			continue
+		}
+
+		if e.Kind == gamelogModel.EventKindResume {
+			// the events after the checkpoint were lost with the restart and are played again
+			if len(e.Rates) < len(r.Players) {
+				r.Players = r.Players[:len(e.Rates)]
+			}
+
+			for i, player := range r.Players {
+				if e.Rates[i] < len(player.Rates) {
+					player.Rates = player.Rates[:e.Rates[i]]
+				}
+			}
+
+			dropped = map[int]gamelogModel.Event{}
+			rates = map[int]*model.Rate{}
+			answered = map[int]int{}
+
+			continue
+		}
+
+		if e.Kind == gamelogModel.EventKindPlayerJoined {
+			if e.Player != len(r.Players) {
+				return nil, fmt.Errorf("player %d joined out of order", e.Player)
+			}
+
+			player := &model.Player{
+				UserID:  e.UserID,
+				ChatID:  e.ChatID,
+				Offline: e.Offline,
+				State:   model.PlayerStateKindPlaying,
+				Rates:   []*model.Rate{},
+
+				Spectator: e.Spectator,
+				Team:      e.Team,
+			}
+			player.User.ID = e.UserID
+			player.User.FirstName = e.FirstName
+			r.Players = append(r.Players, player)
+
+			continue
+		}
+
+		if e.Player < 0 || e.Player >= len(r.Players) {
+			return nil, fmt.Errorf("%s event of unknown player %d", e.Kind, e.Player)
+		}
+
+		player := r.Players[e.Player]
+		switch e.Kind {
+		case gamelogModel.EventKindPlayerLeft:
+			player.State = model.PlayerStateKindLeaving
+		case gamelogModel.EventKindBloops:
+			dropped[e.Player] = e
+		case gamelogModel.EventKindStop:
+			points := e.Seconds
+			if r.Config.TypedAnswers {
+				points = e.Answers * resource.AnswerPoints
+				answered[e.Player] = points
+			}
+
+			rate := &model.Rate{Duration: e.Duration, Completed: points > 0, Points: points}
+			if bloops, ok := dropped[e.Player]; ok {
+				rate.Bloops = true
+				rate.BloopsName = bloops.BloopsName
+				if rate.Completed {
+					rate.Points += bloops.Points
+				}
+				delete(dropped, e.Player)
+			}
+
+			player.Rates = append(player.Rates, rate)
+			rates[e.Player] = rate
+		case gamelogModel.EventKindVote:
+			if rate, ok := rates[e.Player]; ok {
+				rate.Points = e.Answers*resource.AnswerPoints +
+					resolveVote(r.Config.VoteRule, rate.Points-answered[e.Player], e.ThumbUp, e.ThumbDown)
+				if rate.Points == 0 {
+					rate.Completed = false
+				}
+			}
+		case gamelogModel.EventKindTeam:
+			player.Team = e.Team
+		case gamelogModel.EventKindPoints:
+			if len(player.Rates) > 0 {
+				rate := player.Rates[len(player.Rates)-1]
+				rate.Points = e.Points
+				rate.Completed = e.Points > 0
+			}
+		case gamelogModel.EventKindLetter:
+		default:
+			return nil, fmt.Errorf("unknown event kind %s", e.Kind)
+		}
+	}
+
+	return r, nil
+}
+
+func bloopsEvent(bloops resource.Bloops) gamelogModel.Event {
+	return gamelogModel.Event{BloopsName: bloops.Name, Points: bloops.Points, Seconds: bloops.Seconds}
+}
@@ -0,0 +1,129 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+)
+
+func TestReplay(t *testing.T) {
+	t.Parallel()
+
+	var events []gamelogModel.Event
+	session := NewSession(Config{
+		Seed:      42,
+		RoundsNum: 1,
+		Tg:        transport.NewRecorder(),
+		LogFn: func(session *Session, e gamelogModel.Event) error {
+			events = append(events, e)
+			return nil
+		},
+	})
+
+	session.logEvent(gamelogModel.Event{Kind: gamelogModel.EventKindStart, Seed: session.Config.Seed, RoundsNum: 1})
+	host := userModel.User{ID: 1, FirstName: "host"}
+	for _, player := range []*model.Player{
+		model.NewPlayer(1, host, false),
+		model.NewPlayer(1, userModel.User{ID: 1, FirstName: "guest"}, true),
+		model.NewPlayer(2, userModel.User{ID: 2, FirstName: "friend"}, false),
+	} {
+		if err := session.AddPlayer(player); err != nil {
+			t.Fatalf("add player: %v", err)
+		}
+	}
+
+	events = append(events,
+		gamelogModel.Event{Kind: gamelogModel.EventKindLetter, Player: 0, Letter: "A"},
+		gamelogModel.Event{Kind: gamelogModel.EventKindBloops, Player: 0, BloopsName: "bonus", Points: 10},
+		gamelogModel.Event{Kind: gamelogModel.EventKindStop, Player: 0, Seconds: 12, Duration: 18 * time.Second},
+		gamelogModel.Event{Kind: gamelogModel.EventKindVote, Player: 0, ThumbUp: 2, ThumbDown: 1},
+		gamelogModel.Event{Kind: gamelogModel.EventKindStop, Player: 1, Seconds: 20, Duration: 10 * time.Second},
+		gamelogModel.Event{Kind: gamelogModel.EventKindVote, Player: 1, ThumbUp: 0, ThumbDown: 2},
+		gamelogModel.Event{Kind: gamelogModel.EventKindStop, Player: 2, Seconds: 5, Duration: 25 * time.Second},
+	)
+
+	replayed, err := Replay(events)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+
+	if replayed.Config.Seed != 42 {
+		t.Errorf("replay seed: expected 42, got %d", replayed.Config.Seed)
+	}
+
+	expected := map[string]int{"host": 22, "friend": 5, "guest": 0}
+	scores := replayed.Scores()
+	if len(scores) != len(expected) {
+		t.Fatalf("replay scores: expected %d players, got %d", len(expected), len(scores))
+	}
+
+	for _, score := range scores {
+		if points := expected[score.Player.User.FirstName]; score.Points != points {
+			t.Errorf("replay scores %s: expected %d points, got %d", score.Player.User.FirstName, points, score.Points)
+		}
+	}
+}
+
+func TestReplayTypedAnswers(t *testing.T) {
+	t.Parallel()
+
+	events := []gamelogModel.Event{
+		{Kind: gamelogModel.EventKindStart, Seed: 42, RoundsNum: 1, TypedAnswers: true},
+		{Kind: gamelogModel.EventKindPlayerJoined, Player: 0, UserID: 1, FirstName: "host"},
+		{Kind: gamelogModel.EventKindPlayerJoined, Player: 1, UserID: 2, FirstName: "friend"},
+		{Kind: gamelogModel.EventKindBloops, Player: 0, BloopsName: "bonus", Points: 10},
+		{Kind: gamelogModel.EventKindStop, Player: 0, Answers: 3, KnownAnswers: 1},
+		// one of the typed answers is rejected, the round is approved
+		{Kind: gamelogModel.EventKindVote, Player: 0, ThumbUp: 1, Answers: 2},
+		{Kind: gamelogModel.EventKindStop, Player: 1, Answers: 2},
+		{Kind: gamelogModel.EventKindVote, Player: 1, ThumbDown: 1},
+	}
+
+	replayed, err := Replay(events)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+
+	expected := map[string]int{"host": 16, "friend": 0}
+	for _, score := range replayed.Scores() {
+		if points := expected[score.Player.User.FirstName]; score.Points != points {
+			t.Errorf("replay scores %s: expected %d points, got %d", score.Player.User.FirstName, points, score.Points)
+		}
+	}
+}
+
+func TestReplayResume(t *testing.T) {
+	t.Parallel()
+
+	events := []gamelogModel.Event{
+		{Kind: gamelogModel.EventKindStart, Seed: 42, RoundsNum: 1},
+		{Kind: gamelogModel.EventKindPlayerJoined, Player: 0, UserID: 1, FirstName: "host"},
+		{Kind: gamelogModel.EventKindPlayerJoined, Player: 1, UserID: 2, FirstName: "friend"},
+		{Kind: gamelogModel.EventKindStop, Player: 0, Seconds: 12},
+		// the turn of the friend is interrupted by the restart after the checkpoint of the host turn
+		{Kind: gamelogModel.EventKindStop, Player: 1, Seconds: 30},
+		{Kind: gamelogModel.EventKindPlayerJoined, Player: 2, UserID: 3, FirstName: "late"},
+		{Kind: gamelogModel.EventKindResume, Draws: 5, Rates: []int{1, 0}},
+		{Kind: gamelogModel.EventKindStop, Player: 1, Seconds: 4},
+	}
+
+	replayed, err := Replay(events)
+	if err != nil {
+		t.Fatalf("replay: %v", err)
+	}
+
+	if len(replayed.Players) != 2 {
+		t.Fatalf("expected the players of the checkpoint, got %d", len(replayed.Players))
+	}
+
+	expected := map[string]int{"host": 12, "friend": 4}
+	for _, score := range replayed.Scores() {
+		if points := expected[score.Player.User.FirstName]; score.Points != points || score.Rounds != 1 {
+			t.Errorf("replay scores %s: expected %d points in 1 round, got %+v", score.Player.User.FirstName, points, score)
+		}
+	}
+}
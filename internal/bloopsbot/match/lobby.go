@@ -0,0 +1,34 @@
+package match
+
+import "time"
+
+// LobbyEntry describes the public game in the list of the games
+type LobbyEntry struct {
+	Code       int64
+	Players    int
+	MaxPlayers int
+	CreatedAt  time.Time
+	// settings summary in the language of the game
+	Settings string
+}
+
+// LobbyEntry returns the entry of the game in the list of the public games,
+// the game is listed while it is waiting for the players and has free places, the private game is never listed
+func (r *Session) LobbyEntry() (LobbyEntry, bool) {
+	if !r.Config.Public || r.Config.Private || r.getState() != StateKindWaiting {
+		return LobbyEntry{}, false
+	}
+
+	players := r.AlivePlayersLen()
+	if r.Config.MaxPlayers > 0 && players >= r.Config.MaxPlayers {
+		return LobbyEntry{}, false
+	}
+
+	return LobbyEntry{
+		Code:       r.Config.Code,
+		Players:    players,
+		MaxPlayers: r.Config.MaxPlayers,
+		CreatedAt:  r.CreatedAt,
+		Settings:   r.renderSetting(),
+	}, true
+}
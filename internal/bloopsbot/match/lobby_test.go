@@ -0,0 +1,68 @@
+package match
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+)
+
+func TestSessionLobbyEntry(t *testing.T) {
+	t.Parallel()
+
+	session := newTestSession(transport.NewRecorder(), 1, 2)
+	session.State = StateKindWaiting
+	if _, ok := session.LobbyEntry(); ok {
+		t.Fatalf("expected the game that is not public not to be listed")
+	}
+
+	session.Config.Public = true
+	session.Config.MaxPlayers = 3
+	entry, ok := session.LobbyEntry()
+	if !ok || entry.Players != 2 || entry.MaxPlayers != 3 || entry.Settings == "" {
+		t.Fatalf("expected the public game to be listed, got %+v", entry)
+	}
+
+	if err := session.AddPlayer(model.NewSpectator(3, userModel.User{ID: 3})); err != nil {
+		t.Fatalf("add spectator: %v", err)
+	}
+
+	if err := session.AddPlayer(model.NewPlayer(4, userModel.User{ID: 4}, false)); err != nil {
+		t.Fatalf("add player: %v", err)
+	}
+
+	if _, ok := session.LobbyEntry(); ok {
+		t.Fatalf("expected the full game not to be listed")
+	}
+
+	if err := session.AddPlayer(model.NewPlayer(5, userModel.User{ID: 5}, false)); !errors.Is(err, ErrGameFull) {
+		t.Fatalf("expected the game to be full, got %v", err)
+	}
+
+	session.Config.MaxPlayers = 0
+	session.Config.Private = true
+	if _, ok := session.LobbyEntry(); ok {
+		t.Fatalf("expected the private game not to be listed")
+	}
+
+	session.Config.Private = false
+	session.State = StateKindPlaying
+	if _, ok := session.LobbyEntry(); ok {
+		t.Fatalf("expected the started game not to be listed")
+	}
+}
+
+func TestDefaultConfig(t *testing.T) {
+	t.Parallel()
+
+	config := DefaultConfig("en")
+	if config.Locale != "en" || len(config.Categories) == 0 || len(config.Letters) == 0 {
+		t.Fatalf("expected the default settings of the locale, got %+v", config)
+	}
+
+	if config.RoundsNum == 0 || config.RoundTime == 0 || config.Vote || config.IsBloops() {
+		t.Fatalf("expected the default rounds without the vote and the bloopses, got %+v", config)
+	}
+}
@@ -3,39 +3,49 @@ package match
 import (
 	"context"
 	"fmt"
-	"math/rand"
 	"strconv"
 	"sync"
 	"time"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	"github.com/bloops-games/bloops/internal/bloopsbot/util"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
 	"github.com/bloops-games/bloops/internal/database/matchstate/model"
 	"github.com/bloops-games/bloops/internal/logging"
 	"github.com/bloops-games/bloops/internal/strpool"
 	"github.com/enescakir/emoji"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
-	"github.com/valyala/fastrand"
 	"golang.org/x/sync/errgroup"
 )
 
 // notification of the player's readiness and sending the start button
 func (r *Session) sendStartMsg(player *model.Player) error {
-	msg := tgbotapi.NewMessage(player.ChatID, r.renderStartMsg())
-	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(resource.TextStartBtnData, resource.TextStartBtnData),
+	messageID, err := r.tg.SendText(transport.Message{
+		ChatID:   player.ChatID,
+		Text:     r.renderStartMsg(),
+		Markdown: true,
+		Keyboard: tgbotapi.NewInlineKeyboardMarkup(
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(r.lang.TextStartBtn, resource.StartBtnData),
+			),
 		),
-	)
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	output, err := r.tg.Send(msg)
+	})
 	if err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
-	r.registerCbHandler(output.MessageID, func(query *tgbotapi.CallbackQuery) error {
-		if query.Data == resource.TextStartBtnData {
-			if _, err := r.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.TextStartBtnDataAnswer)); err != nil {
+	r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+		if !isQueryFrom(query, player) {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextNotYourTurnMsg); err != nil {
+				return fmt.Errorf("send answer: %w", err)
+			}
+
+			return nil
+		}
+
+		if query.Data == resource.StartBtnData {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextStartBtnDataAnswer); err != nil {
 				return fmt.Errorf("send answer: %w", err)
 			}
 			r.startCh <- struct{}{}
@@ -43,7 +53,7 @@ func (r *Session) sendStartMsg(player *model.Player) error {
 
 		r.mtx.Lock()
 		defer r.mtx.Unlock()
-		delete(r.msgCallback, output.MessageID)
+		delete(r.msgCallback, messageID)
 
 		return nil
 	})
@@ -52,48 +62,58 @@ func (r *Session) sendStartMsg(player *model.Player) error {
 }
 
 func (r *Session) checkBloopsSendMsg(player *model.Player) (int, error) {
-	msg := tgbotapi.NewMessage(player.ChatID, emoji.GameDie.String()+"...")
-	output, err := r.tg.Send(msg)
+	messageID, err := r.tg.SendText(transport.Message{ChatID: player.ChatID, Text: emoji.GameDie.String() + "..."})
 	if err != nil {
 		return 0, fmt.Errorf("send msg: %w", err)
 	}
 	util.Sleep(1 * time.Second)
 	for i := 3; i > 0; i-- {
-		msg := tgbotapi.NewEditMessageText(player.ChatID, output.MessageID, emoji.GameDie.String()+"..."+strconv.Itoa(i))
-		if _, err := r.tg.Send(msg); err != nil {
-			return output.MessageID, fmt.Errorf("send msg: %w", err)
+		if err := r.tg.EditText(transport.Message{
+			ChatID:    player.ChatID,
+			MessageID: messageID,
+			Text:      emoji.GameDie.String() + "..." + strconv.Itoa(i),
+		}); err != nil {
+			return messageID, fmt.Errorf("send msg: %w", err)
 		}
 		util.Sleep(1 * time.Second)
 	}
 
-	return output.MessageID, nil
+	return messageID, nil
 }
 
 func (r *Session) sendDroppedBloopsesMsg(player *model.Player, bloops *resource.Bloops) error {
 	{
-		msg := tgbotapi.NewStickerShare(player.ChatID, resource.BloopsStickerDropBloops)
-		if _, err := r.tg.Send(msg); err != nil {
+		if _, err := r.tg.SendSticker(player.ChatID, resource.BloopsStickerDropBloops); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 	}
 	util.Sleep(1 * time.Second)
 	{
-		msg := tgbotapi.NewMessage(player.ChatID, r.renderDropBloopsMsg(bloops))
-		msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData(resource.TextChallengeBtnDataAnswer, resource.TextChallengeBtnDataAnswer),
+		messageID, err := r.tg.SendText(transport.Message{
+			ChatID:   player.ChatID,
+			Text:     r.renderDropBloopsMsg(bloops),
+			Markdown: true,
+			Keyboard: tgbotapi.NewInlineKeyboardMarkup(
+				tgbotapi.NewInlineKeyboardRow(
+					tgbotapi.NewInlineKeyboardButtonData(r.lang.TextChallengeBtn, resource.ChallengeBtnData),
+				),
 			),
-		)
-
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		output, err := r.tg.Send(msg)
+		})
 		if err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
-		r.registerCbHandler(output.MessageID, func(query *tgbotapi.CallbackQuery) error {
-			if query.Data == resource.TextChallengeBtnDataAnswer {
-				if _, err := r.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.TextChallengeBtnDataAnswer)); err != nil {
+		r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+			if !isQueryFrom(query, player) {
+				if err := r.tg.AnswerCallback(query.ID, r.lang.TextNotYourTurnMsg); err != nil {
+					return fmt.Errorf("send answer: %w", err)
+				}
+
+				return nil
+			}
+
+			if query.Data == resource.ChallengeBtnData {
+				if err := r.tg.AnswerCallback(query.ID, r.lang.TextChallengeBtn); err != nil {
 					return fmt.Errorf("send answer: %w", err)
 				}
 				r.startCh <- struct{}{}
@@ -101,7 +121,7 @@ func (r *Session) sendDroppedBloopsesMsg(player *model.Player, bloops *resource.
 
 			r.mtx.Lock()
 			defer r.mtx.Unlock()
-			delete(r.msgCallback, output.MessageID)
+			delete(r.msgCallback, messageID)
 
 			return nil
 		})
@@ -111,12 +131,12 @@ func (r *Session) sendDroppedBloopsesMsg(player *model.Player, bloops *resource.
 }
 
 // select the letter that the player needs to call the words
-func (r *Session) sendLetterMsg(player *model.Player) error {
+func (r *Session) sendLetterMsg(player *model.Player) (string, error) {
 	buf := strpool.Get()
 
-	output, err := r.tg.Send(tgbotapi.NewMessage(player.ChatID, resource.TextStartLetterMsg))
+	messageID, err := r.tg.SendText(transport.Message{ChatID: player.ChatID, Text: r.lang.TextStartLetterMsg})
 	if err != nil {
-		return fmt.Errorf("send msg: %w", err)
+		return "", fmt.Errorf("send msg: %w", err)
 	}
 
 	sndCh := make(chan string, 1)
@@ -124,7 +144,7 @@ func (r *Session) sendLetterMsg(player *model.Player) error {
 	g := errgroup.Group{}
 	g.Go(func() error {
 		for msg := range sndCh {
-			if _, err := r.tg.Send(tgbotapi.NewEditMessageText(player.ChatID, output.MessageID, msg)); err != nil {
+			if err := r.tg.EditText(transport.Message{ChatID: player.ChatID, MessageID: messageID, Text: msg}); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 		}
@@ -136,8 +156,8 @@ func (r *Session) sendLetterMsg(player *model.Player) error {
 	for i := 0; i < generateLetterTimes; i++ {
 		for buf.String() == sentMsg {
 			buf.Reset()
-			idx := fastrand.Uint32n(uint32(len(r.Config.Letters)))
-			buf.WriteString(resource.TextStartLetterMsg)
+			idx := r.rnd.Intn(len(r.Config.Letters))
+			buf.WriteString(r.lang.TextStartLetterMsg)
 			buf.WriteString(r.Config.Letters[idx])
 			sentLetter = r.Config.Letters[idx]
 		}
@@ -150,15 +170,16 @@ func (r *Session) sendLetterMsg(player *model.Player) error {
 	buf.Reset()
 	strpool.Put(buf)
 
+	r.logPlayerEvent(gamelogModel.EventKindLetter, player, gamelogModel.Event{Letter: sentLetter})
 	r.syncBroadcast(r.renderStartHelpMsg(player, sentLetter), player.UserID)
 
 	close(sndCh)
 
 	if err := g.Wait(); err != nil {
-		return err
+		return "", err
 	}
 
-	return nil
+	return sentLetter, nil
 }
 
 // send ready -> set -> go steps
@@ -173,24 +194,21 @@ func (r *Session) sendReadyMsg(player *model.Player) error {
 	buf.WriteString(emoji.Keycap3.String())
 	buf.WriteString(" ...")
 	{
-		msg := tgbotapi.NewMessage(player.ChatID, buf.String())
-		msg.ParseMode = tgbotapi.ModeMarkdown
-
-		output, err := r.tg.Send(msg)
+		output, err := r.tg.SendText(transport.Message{ChatID: player.ChatID, Text: buf.String(), Markdown: true})
 		if err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
-		messageID = output.MessageID
+		messageID = output
 		util.Sleep(1 * time.Second)
 	}
 
 	buf.Reset()
 	buf.WriteString(emoji.Keycap2.String())
-	buf.WriteString(" На старт")
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextReadyMsg)
 	{
-		msg := tgbotapi.NewEditMessageText(player.ChatID, messageID, buf.String())
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		if _, err := r.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: player.ChatID, MessageID: messageID, Text: buf.String(), Markdown: true}
+		if err := r.tg.EditText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -199,12 +217,12 @@ func (r *Session) sendReadyMsg(player *model.Player) error {
 
 	buf.Reset()
 	buf.WriteString(emoji.Keycap1.String())
-	buf.WriteString(" Внимание")
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextSteadyMsg)
 
 	{
-		msg := tgbotapi.NewEditMessageText(player.ChatID, messageID, buf.String())
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		if _, err := r.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: player.ChatID, MessageID: messageID, Text: buf.String(), Markdown: true}
+		if err := r.tg.EditText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -213,12 +231,12 @@ func (r *Session) sendReadyMsg(player *model.Player) error {
 
 	buf.Reset()
 	buf.WriteString(emoji.Rocket.String())
-	buf.WriteString(" Марш!")
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextGoMsg)
 
 	{
-		msg := tgbotapi.NewEditMessageText(player.ChatID, messageID, buf.String())
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		if _, err := r.tg.Send(msg); err != nil {
+		msg := transport.Message{ChatID: player.ChatID, MessageID: messageID, Text: buf.String(), Markdown: true}
+		if err := r.tg.EditText(msg); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 	}
@@ -237,22 +255,24 @@ func (r *Session) sendFreezeTimerMsg(player *model.Player, secs int) (int, error
 	buf.WriteString(emoji.Stopwatch.String())
 	buf.WriteString(" ")
 	buf.WriteString(strconv.Itoa(secs))
-	buf.WriteString(" сек")
-	msg := tgbotapi.NewMessage(player.ChatID, resource.TextStopButton)
-	msg.ParseMode = tgbotapi.ModeMarkdown
-	msg.ReplyMarkup = tgbotapi.NewInlineKeyboardMarkup(
-		tgbotapi.NewInlineKeyboardRow(
-			tgbotapi.NewInlineKeyboardButtonData(buf.String(), resource.TextTimerBtnData),
-			tgbotapi.NewInlineKeyboardButtonData(resource.TextStopBtnData, resource.TextStopBtnData),
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextSecondsShort)
+	output, err := r.tg.SendText(transport.Message{
+		ChatID:   player.ChatID,
+		Text:     r.lang.TextStopButton,
+		Markdown: true,
+		Keyboard: tgbotapi.NewInlineKeyboardMarkup(
+			tgbotapi.NewInlineKeyboardRow(
+				tgbotapi.NewInlineKeyboardButtonData(buf.String(), resource.TimerBtnData),
+				tgbotapi.NewInlineKeyboardButtonData(r.lang.TextStopBtn, resource.StopBtnData),
+			),
 		),
-	)
-
-	output, err := r.tg.Send(msg)
+	})
 	if err != nil {
 		return messageID, fmt.Errorf("send msg: %w", err)
 	}
 
-	return output.MessageID, nil
+	return output, nil
 }
 
 // formatting stop, timer button and send it
@@ -266,83 +286,138 @@ func (r *Session) sendWorkingTimerMsg(player *model.Player, messageID, secs int)
 	buf.WriteString(emoji.Stopwatch.String())
 	buf.WriteString(" ")
 	buf.WriteString(strconv.Itoa(secs))
-	buf.WriteString(" сек")
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextSecondsShort)
 
-	msg := tgbotapi.NewEditMessageReplyMarkup(
-		player.ChatID,
-		messageID,
-		tgbotapi.NewInlineKeyboardMarkup(
-			tgbotapi.NewInlineKeyboardRow(
-				tgbotapi.NewInlineKeyboardButtonData(buf.String(), resource.TextTimerBtnData),
-				tgbotapi.NewInlineKeyboardButtonData(resource.TextStopBtnData, resource.TextStopBtnData),
-			),
+	markup := tgbotapi.NewInlineKeyboardMarkup(
+		tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(buf.String(), resource.TimerBtnData),
+			tgbotapi.NewInlineKeyboardButtonData(r.lang.TextStopBtn, resource.StopBtnData),
 		),
 	)
 
-	if _, err := r.tg.Send(msg); err != nil {
+	if err := r.tg.EditKeyboard(player.ChatID, messageID, markup); err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
 
 	return nil
 }
 
-func (r *Session) sendVotesMsg(voteMessages map[int64]int) error {
+// sendPausedTimerMsg shows the frozen timer of the paused game, the timer can't be stopped until the resume
+func (r *Session) sendPausedTimerMsg(player *model.Player, messageID, secs int) error {
+	buf := strpool.Get()
+	defer func() {
+		buf.Reset()
+		strpool.Put(buf)
+	}()
+
+	buf.WriteString(emoji.PauseButton.String())
+	buf.WriteString(" ")
+	buf.WriteString(strconv.Itoa(secs))
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextSecondsShort)
+	buf.WriteString(", ")
+	buf.WriteString(r.lang.TextTimerPaused)
+
 	markup := tgbotapi.NewInlineKeyboardMarkup(
 		tgbotapi.NewInlineKeyboardRow(
-			thumbUpButton(r.activeVote.thumbUp),
-			thumbDownButton(r.activeVote.thumbDown),
+			tgbotapi.NewInlineKeyboardButtonData(buf.String(), resource.TimerBtnData),
 		),
 	)
 
+	if err := r.tg.EditKeyboard(player.ChatID, messageID, markup); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+func (r *Session) sendVotesMsg(voteMessages map[int]int64, rate *model.Rate) error {
+	r.mtx.RLock()
+	markup := r.renderVoteButtons()
+	chats := r.recipients()
+	var guests []*model.Player
+	for _, p := range r.Players {
+		if p.Offline && r.canVote(p, r.activeVote.player) {
+			guests = append(guests, p)
+		}
+	}
+	r.mtx.RUnlock()
+
 	// creating a voting system and defining callbacks for voting
-	for _, player := range r.Players {
-		if player.IsPlaying() && !player.Offline {
-			msg := tgbotapi.NewMessage(player.ChatID, resource.TextVoteMsg)
-			msg.ReplyMarkup = markup
-			// sending the thumbs up and thumbs down buttons
-			output, err := r.tg.Send(msg)
-			if err != nil {
-				return fmt.Errorf("send msg: %w", err)
-			}
-			// registering callbacks for voting
-			voteMessages[player.ChatID] = output.MessageID
-			r.registerCbHandler(output.MessageID, func(query *tgbotapi.CallbackQuery) error {
-				switch query.Data {
-				case resource.TextThumbUp:
-					r.thumbUp()
-				case resource.TextThumbDown:
-					r.thumbDown()
-				default:
+	for _, chatID := range chats {
+		// sending the thumbs up and thumbs down buttons
+		messageID, err := r.tg.SendText(transport.Message{ChatID: chatID, Text: r.renderVoteMsg(rate), Keyboard: markup})
+		if err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+		// registering callbacks for voting
+		voteMessages[messageID] = chatID
+		r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+			answer := r.lang.TextVoteNotAllowed
+			if ballot, ok := ballotsData[query.Data]; ok && query.From != nil {
+				if r.castBallot(int64(query.From.ID), ballot) {
+					answer = fmt.Sprintf(r.lang.TextVoteAnswer, query.Data)
 				}
-
-				if _, err := r.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, query.Data)); err != nil {
-					return fmt.Errorf("send answer msg: %w", err)
+			} else if idx, ok := parseRejectAnswerData(query.Data); ok && query.From != nil {
+				if rejected, ok := r.rejectAnswer(int64(query.From.ID), idx); ok {
+					answer = r.renderRejectAnswer(rate, idx, rejected)
 				}
+			}
 
-				return nil
-			})
+			if err := r.tg.AnswerCallback(query.ID, answer); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		})
+	}
+
+	// the host votes for each of the offline players with a separate message
+	for _, guest := range guests {
+		guest := guest
+		text := fmt.Sprintf(r.lang.TextOfflineVoteMsg, guest.FormatFirstName()) + r.renderVoteMsg(rate)
+		messageID, err := r.tg.SendText(transport.Message{ChatID: guest.ChatID, Text: text, Keyboard: markup})
+		if err != nil {
+			return fmt.Errorf("send msg: %w", err)
 		}
+
+		voteMessages[messageID] = guest.ChatID
+		r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+			answer := r.lang.TextVoteNotAllowed
+			if ballot, ok := ballotsData[query.Data]; ok && isQueryFrom(query, guest) {
+				r.mtx.Lock()
+				ok := r.castPlayerBallot(guest, ballot)
+				r.mtx.Unlock()
+				if ok {
+					answer = fmt.Sprintf(r.lang.TextVoteAnswer, query.Data)
+				}
+			} else if idx, ok := parseRejectAnswerData(query.Data); ok && isQueryFrom(query, guest) {
+				r.mtx.Lock()
+				rejected, ok := r.rejectPlayerAnswer(guest, idx)
+				r.mtx.Unlock()
+				if ok {
+					answer = r.renderRejectAnswer(rate, idx, rejected)
+				}
+			}
+
+			if err := r.tg.AnswerCallback(query.ID, answer); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		})
 	}
 
 	return nil
 }
 
-func (r *Session) sendChangingVotesMsg(voteMessages map[int64]int) error {
+func (r *Session) sendChangingVotesMsg(voteMessages map[int]int64) error {
 	r.mtx.RLock()
 	// send all users changes in votes so that all players can see the overall result
-	for chatID, messageID := range voteMessages {
-		msg := tgbotapi.NewEditMessageReplyMarkup(
-			chatID,
-			messageID,
-			tgbotapi.NewInlineKeyboardMarkup(
-				tgbotapi.NewInlineKeyboardRow(
-					thumbUpButton(r.activeVote.thumbUp),
-					thumbDownButton(r.activeVote.thumbDown),
-				),
-			),
-		)
-
-		if _, err := r.tg.Send(msg); err != nil {
+	for messageID, chatID := range voteMessages {
+		markup := r.renderVoteButtons()
+		if err := r.tg.EditKeyboard(chatID, messageID, markup); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 	}
@@ -351,7 +426,7 @@ func (r *Session) sendChangingVotesMsg(voteMessages map[int64]int) error {
 }
 
 func (r *Session) sendRoundClosed() {
-	r.syncBroadcast(fmt.Sprintf(resource.TextRoundFavoriteMsg, r.CurrRoundIdx+1))
+	r.syncBroadcast(fmt.Sprintf(r.lang.TextRoundFavoriteMsg, r.CurrRoundIdx+1))
 }
 
 func (r *Session) sendWhoFavoritesMsg() {
@@ -363,12 +438,9 @@ func (r *Session) sendWhoFavoritesMsg() {
 func (r *Session) sendStartSticker() error {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
-	for _, player := range r.Players {
-		if player.IsPlaying() && !player.Offline {
-			msg := tgbotapi.NewStickerShare(player.ChatID, resource.BloopsStickerBlockFinished)
-			if _, err := r.tg.Send(msg); err != nil {
-				return fmt.Errorf("send msg: %w", err)
-			}
+	for _, chatID := range r.recipients() {
+		if _, err := r.tg.SendSticker(chatID, resource.BloopsStickerBlockFinished); err != nil {
+			return fmt.Errorf("send msg: %w", err)
 		}
 	}
 
@@ -376,7 +448,7 @@ func (r *Session) sendStartSticker() error {
 }
 
 func (r *Session) sendCrashMsg() {
-	r.syncBroadcast(resource.TextBroadcastCrashMsg)
+	r.syncBroadcast(r.lang.TextBroadcastCrashMsg)
 }
 
 const maxXlCellsRow = 2
@@ -427,16 +499,17 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 
 	for i := 0; i < rewardsNum; i++ {
 		if i < treasuresNum {
-			idx := fastrand.Uint32n(uint32(len(resource.Bloopses)))
-			bloops[i] = resource.Bloopses[idx].Name
+			idx := r.rnd.Intn(len(r.lang.Bloopses))
+			bloops[i] = r.lang.Bloopses[idx].Name
 		} else {
-			bloops[i] = "Обычный раунд"
+			bloops[i] = r.lang.TextRegularRound
 		}
 	}
 
-	rand.Shuffle(len(bloops), func(i, j int) {
+	for i := len(bloops) - 1; i > 0; i-- {
+		j := r.rnd.Intn(i + 1)
 		bloops[i], bloops[j] = bloops[j], bloops[i]
-	})
+	}
 
 	markup := tgbotapi.NewInlineKeyboardMarkup()
 	{
@@ -447,7 +520,7 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 				row = tgbotapi.NewInlineKeyboardRow()
 			}
 
-			row = append(row, tgbotapi.NewInlineKeyboardButtonData(emoji.GemStone.String()+" Неизвестная карта", strconv.Itoa(idx)))
+			row = append(row, tgbotapi.NewInlineKeyboardButtonData(r.lang.TextUnknownCard, strconv.Itoa(idx)))
 		}
 
 		if len(row) > 0 {
@@ -455,9 +528,11 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 		}
 	}
 
-	msg := tgbotapi.NewMessage(player.ChatID, "Выбери карту, тебе может попасться блюпс")
-	msg.ReplyMarkup = markup
-	output, err := r.tg.Send(msg)
+	messageID, err := r.tg.SendText(transport.Message{
+		ChatID:   player.ChatID,
+		Text:     r.lang.TextChooseCardMsg,
+		Keyboard: markup,
+	})
 	if err != nil {
 		return fmt.Errorf("send msg: %w", err)
 	}
@@ -465,17 +540,17 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 	opened := newOpenedReward()
 
 	mtx := sync.RWMutex{}
-	r.registerCbHandler(output.MessageID, func(query *tgbotapi.CallbackQuery) error {
+	r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
 		logger := logging.FromContext(ctx).Named("match.sendChoiceBloopsMsg")
 		defer func() {
 			if opened.equal(attempts) {
 				util.Sleep(3 * time.Second)
 
 				r.mtx.Lock()
-				delete(r.msgCallback, output.MessageID)
+				delete(r.msgCallback, messageID)
 				r.mtx.Unlock()
 
-				if _, err := r.tg.Send(tgbotapi.NewDeleteMessage(player.ChatID, output.MessageID)); err != nil {
+				if err := r.tg.DeleteMessage(player.ChatID, messageID); err != nil {
 					logger.Errorf("send msg: %v", err)
 				}
 
@@ -494,15 +569,15 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 
 		mtx.RLock()
 
-		var cbConfig tgbotapi.CallbackConfig
+		var answer string
 		if bloops[n] != emoji.CrossMark.String() {
-			cbConfig = tgbotapi.NewCallback(query.ID, "Нашел!")
+			answer = r.lang.TextCardFoundAnswer
 		} else {
-			cbConfig = tgbotapi.NewCallback(query.ID, "Тут ничего!")
+			answer = r.lang.TextCardEmptyAnswer
 		}
 
 		mtx.RUnlock()
-		if _, err := r.tg.AnswerCallbackQuery(cbConfig); err != nil {
+		if err := r.tg.AnswerCallback(query.ID, answer); err != nil {
 			return fmt.Errorf("send answer: %w", err)
 		}
 
@@ -519,7 +594,7 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 			if opened.exist(idx) {
 				row = append(row, tgbotapi.NewInlineKeyboardButtonData(bloops[idx], bloops[idx]))
 			} else {
-				row = append(row, tgbotapi.NewInlineKeyboardButtonData(emoji.GemStone.String()+" Неизвестная карточка", strconv.Itoa(idx)))
+				row = append(row, tgbotapi.NewInlineKeyboardButtonData(r.lang.TextUnknownCard, strconv.Itoa(idx)))
 			}
 		}
 
@@ -528,7 +603,7 @@ func (r *Session) sendChoiceBloopsMsg(ctx context.Context, player *model.Player)
 			markup.InlineKeyboard = append(markup.InlineKeyboard, row)
 		}
 
-		if _, err := r.tg.Send(tgbotapi.NewEditMessageReplyMarkup(player.ChatID, output.MessageID, markup)); err != nil {
+		if err := r.tg.EditKeyboard(player.ChatID, messageID, markup); err != nil {
 			return fmt.Errorf("send msg: %w", err)
 		}
 
@@ -0,0 +1,398 @@
+package match
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+// moderation inline button data
+const (
+	manageKickData   = "manage:kick"
+	manageSkipData   = "manage:skip"
+	managePauseData  = "manage:pause"
+	manageResumeData = "manage:resume"
+	managePointsData = "manage:points"
+	manageEndData    = "manage:end"
+	kickDataPrefix   = "kick:"
+)
+
+// isManage reports whether the author asks for the moderation menu
+func (r *Session) isManage(userID int64, cmd string) bool {
+	return r.Config.AuthorID == userID && (cmd == r.lang.ManageButtonText || cmd == resource.CmdGroupManage)
+}
+
+// isQueryFromAuthor reports whether the author pressed the button, in a group chat everyone sees the menu
+func (r *Session) isQueryFromAuthor(query *tgbotapi.CallbackQuery) bool {
+	return query.From == nil || int64(query.From.ID) == r.Config.AuthorID
+}
+
+// sendManageMsg sends the moderation menu to the chat of the author
+func (r *Session) sendManageMsg(chatID int64) error {
+	messageID, err := r.tg.SendText(transport.Message{
+		ChatID:   chatID,
+		Text:     r.lang.TextManageMsg,
+		Keyboard: r.renderManageButtons(),
+	})
+	if err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+		if !r.isQueryFromAuthor(query) {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextManageNotAllowed); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		}
+
+		answer := ""
+		switch query.Data {
+		case manageKickData:
+			if err := r.sendKickMsg(chatID); err != nil {
+				return fmt.Errorf("send kick msg: %w", err)
+			}
+		case manageSkipData:
+			answer = r.lang.TextNoActiveTurnMsg
+			if r.skip() {
+				answer = r.lang.TextManageSkip
+			}
+		case managePauseData:
+			r.pause()
+		case manageResumeData:
+			r.resume()
+		case managePointsData:
+			if err := r.requestPoints(chatID); err != nil {
+				return fmt.Errorf("request points: %w", err)
+			}
+		case manageEndData:
+			r.end()
+		}
+
+		if err := r.tg.AnswerCallback(query.ID, answer); err != nil {
+			return fmt.Errorf("send answer msg: %w", err)
+		}
+
+		if r.getState() == StateKindFinished {
+			return nil
+		}
+
+		if err := r.tg.EditKeyboard(chatID, messageID, r.renderManageButtons()); err != nil {
+			return fmt.Errorf("edit keyboard: %w", err)
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+// sendKickMsg lets the author choose the player to kick
+func (r *Session) sendKickMsg(chatID int64) error {
+	r.mtx.RLock()
+	markup := r.renderKickButtons()
+	r.mtx.RUnlock()
+
+	messageID, err := r.tg.SendText(transport.Message{ChatID: chatID, Text: r.lang.TextChoosePlayerMsg, Keyboard: markup})
+	if err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+		if !r.isQueryFromAuthor(query) {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextManageNotAllowed); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		}
+
+		idx, err := strconv.Atoi(strings.TrimPrefix(query.Data, kickDataPrefix))
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		if err := r.tg.AnswerCallback(query.ID, ""); err != nil {
+			return fmt.Errorf("send answer msg: %w", err)
+		}
+
+		r.mtx.Lock()
+		delete(r.msgCallback, messageID)
+		r.mtx.Unlock()
+		if err := r.tg.DeleteMessage(chatID, messageID); err != nil {
+			return fmt.Errorf("delete msg: %w", err)
+		}
+
+		if err := r.kick(idx); err != nil {
+			return fmt.Errorf("kick: %w", err)
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+// kick removes the player from the game by the index, the offline players of the kicked host leave with them.
+// The turn of the kicked player is passed
+func (r *Session) kick(idx int) error {
+	r.mtx.RLock()
+	if idx < 0 || idx >= len(r.Players) || !r.Players[idx].IsPlaying() {
+		r.mtx.RUnlock()
+		return nil
+	}
+
+	player := r.Players[idx]
+	kicked := []*model.Player{player}
+	for _, p := range r.Players {
+		if !player.Offline && p.Offline && p.UserID == player.UserID && p.IsPlaying() {
+			kicked = append(kicked, p)
+		}
+	}
+
+	var active bool
+	for _, p := range kicked {
+		if r.CurrPlayerIdx >= 0 && r.CurrPlayerIdx < len(r.Players) && r.Players[r.CurrPlayerIdx] == p {
+			active = true
+		}
+	}
+	r.mtx.RUnlock()
+
+	for _, p := range kicked {
+		r.syncBroadcast(fmt.Sprintf(r.lang.TextPlayerKickedMsg, p.FormatFirstName()))
+		r.mtx.Lock()
+		p.State = model.PlayerStateKindLeaving
+		r.mtx.Unlock()
+		r.logPlayerEvent(gamelogModel.EventKindPlayerLeft, p, gamelogModel.Event{})
+	}
+
+	if r.Config.KickFn != nil {
+		if err := r.Config.KickFn(r, player); err != nil {
+			return fmt.Errorf("kick function: %w", err)
+		}
+	}
+
+	if active {
+		r.pass(player.UserID)
+	}
+
+	return nil
+}
+
+// pass interrupts the turn of the player, the send does not block when the turn has already passed
+func (r *Session) pass(userID int64) {
+	select {
+	case r.passCh <- userID:
+	default:
+	}
+}
+
+// skip interrupts the active turn, the player gets no points for it
+func (r *Session) skip() bool {
+	r.mtx.Lock()
+	if !r.skippable || r.CurrPlayerIdx < 0 || r.CurrPlayerIdx >= len(r.Players) {
+		r.mtx.Unlock()
+		return false
+	}
+
+	player := r.Players[r.CurrPlayerIdx]
+	r.skipTurn = true
+	r.mtx.Unlock()
+
+	r.syncBroadcast(fmt.Sprintf(r.lang.TextTurnSkippedMsg, player.FormatFirstName()))
+	r.pass(player.UserID)
+
+	return true
+}
+
+// startTurn lets the author skip the turn until the timer stops
+func (r *Session) startTurn() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.skippable = true
+	r.skipTurn = false
+	// the points of the previous turn can't be changed once the next turn starts
+	r.pointsTurn = nil
+
+	// the pass and the stop of a finished turn are stale
+	select {
+	case <-r.passCh:
+	default:
+	}
+
+	select {
+	case <-r.stopCh:
+	default:
+	}
+}
+
+// takeSkip reports whether the author has skipped the turn, the turn can't be skipped after that
+func (r *Session) takeSkip() bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	skipped := r.skipTurn
+	r.skipTurn = false
+	r.skippable = false
+
+	return skipped
+}
+
+// skipped closes the turn skipped before the timer has started with no points
+func (r *Session) skipped(ctx context.Context, player *model.Player) {
+	r.mtx.Lock()
+	player.Rates = append(player.Rates, &model.Rate{})
+	r.lastTurn = player
+	r.CurrPlayerIdx = -1
+	r.mtx.Unlock()
+	r.logPlayerEvent(gamelogModel.EventKindStop, player, gamelogModel.Event{})
+	r.checkpoint(ctx)
+}
+
+// pointsTurn is the turn of the player the author is changing the points for
+type pointsTurn struct {
+	player *model.Player
+	// index of the rate of the turn in the rates of the player
+	rateIdx int
+}
+
+// requestPoints asks the author for the new points of the last turn
+func (r *Session) requestPoints(chatID int64) error {
+	r.mtx.Lock()
+	player := r.lastTurn
+	if player == nil || len(player.Rates) == 0 {
+		r.mtx.Unlock()
+		if _, err := r.tg.SendText(transport.Message{ChatID: chatID, Text: r.lang.TextNoLastTurnMsg}); err != nil {
+			return fmt.Errorf("send msg: %w", err)
+		}
+
+		return nil
+	}
+
+	r.pointsTurn = &pointsTurn{player: player, rateIdx: len(player.Rates) - 1}
+	points := player.Rates[len(player.Rates)-1].Points
+	r.mtx.Unlock()
+
+	text := fmt.Sprintf(r.lang.TextSendPointsMsg, player.FormatFirstName(), points)
+	if _, err := r.tg.SendText(transport.Message{ChatID: chatID, Text: text, Markdown: true}); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// overridePoints sets the points of the turn the author was asked for, reports whether the message is consumed.
+// The invalid points cancel the change, the author asks for it again
+func (r *Session) overridePoints(userID int64, chatID int64, text string) (bool, error) {
+	r.mtx.Lock()
+	turn := r.pointsTurn
+	if turn == nil || userID != r.Config.AuthorID || r.isButtonText(text) {
+		r.mtx.Unlock()
+		return false, nil
+	}
+
+	r.pointsTurn = nil
+	max := r.maxTurnPoints()
+	points, err := strconv.Atoi(strings.TrimSpace(text))
+	if err != nil || points < 0 || points > max || turn.rateIdx >= len(turn.player.Rates) {
+		r.mtx.Unlock()
+		msg := transport.Message{ChatID: chatID, Text: fmt.Sprintf(r.lang.TextPointsInvalidMsg, max)}
+		if _, err := r.tg.SendText(msg); err != nil {
+			return true, fmt.Errorf("send msg: %w", err)
+		}
+
+		return true, nil
+	}
+
+	player := turn.player
+	rate := player.Rates[turn.rateIdx]
+	prev := rate.Points
+	rate.Points = points
+	rate.Completed = points > 0
+	r.mtx.Unlock()
+
+	r.logPlayerEvent(gamelogModel.EventKindPoints, player, gamelogModel.Event{Points: points})
+	r.syncBroadcast(fmt.Sprintf(r.lang.TextPointsChangedMsg, player.FormatFirstName(), prev, points))
+	r.checkpoint(context.Background())
+
+	return true, nil
+}
+
+// maxTurnPoints returns the most points a turn can get with the biggest bloops reward,
+// the bloops seconds add to the timer and so to the points unless the answers are typed
+func (r *Session) maxTurnPoints() int {
+	var reward, seconds int
+	for _, bloops := range r.Config.Bloopses {
+		if bloops.Points > reward {
+			reward = bloops.Points
+		}
+
+		if bloops.Seconds > seconds {
+			seconds = bloops.Seconds
+		}
+	}
+
+	if r.Config.TypedAnswers {
+		return len(r.Config.Categories)*resource.AnswerPoints + reward
+	}
+
+	return r.Config.RoundTime + seconds + reward
+}
+
+// end finishes the started game early, the stats of the played turns are saved
+func (r *Session) end() {
+	if state := r.getState(); state == StateKindWaiting || state == StateKindFinished {
+		return
+	}
+
+	r.syncBroadcast(r.lang.TextGameEndedByHostMsg)
+	r.Finish()
+}
+
+// renderManageButtons shows the actions available in the current state of the game, must not be called under the lock
+func (r *Session) renderManageButtons() tgbotapi.InlineKeyboardMarkup {
+	rows := [][]tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData(r.lang.TextManageKick, manageKickData)),
+	}
+
+	if r.getState() == StateKindWaiting {
+		return tgbotapi.NewInlineKeyboardMarkup(rows...)
+	}
+
+	pause := tgbotapi.NewInlineKeyboardButtonData(r.lang.TextManagePause, managePauseData)
+	if r.isPaused() {
+		pause = tgbotapi.NewInlineKeyboardButtonData(r.lang.TextManageResume, manageResumeData)
+	}
+
+	rows[0] = append(rows[0], tgbotapi.NewInlineKeyboardButtonData(r.lang.TextManageSkip, manageSkipData))
+	rows = append(
+		rows,
+		tgbotapi.NewInlineKeyboardRow(pause, tgbotapi.NewInlineKeyboardButtonData(r.lang.TextManagePoints, managePointsData)),
+		tgbotapi.NewInlineKeyboardRow(tgbotapi.NewInlineKeyboardButtonData(r.lang.TextManageEnd, manageEndData)),
+	)
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
+// renderKickButtons lists the players the author can kick, must be called under the lock
+func (r *Session) renderKickButtons() tgbotapi.InlineKeyboardMarkup {
+	var rows [][]tgbotapi.InlineKeyboardButton
+	for i, player := range r.Players {
+		if !player.IsPlaying() || !player.Offline && player.UserID == r.Config.AuthorID {
+			continue
+		}
+
+		rows = append(rows, tgbotapi.NewInlineKeyboardRow(
+			tgbotapi.NewInlineKeyboardButtonData(player.FormatFirstName(), kickDataPrefix+strconv.Itoa(i)),
+		))
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
@@ -0,0 +1,127 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+)
+
+func TestSessionModeration(t *testing.T) {
+	t.Parallel()
+
+	tg := transport.NewRecorder()
+	session := newTestSession(tg, 1, 2, 3)
+	session.State = StateKindPlaying
+
+	var kicked []*model.Player
+	session.Config.KickFn = func(_ *Session, player *model.Player) error {
+		kicked = append(kicked, player)
+		return nil
+	}
+
+	// the skipped turn is passed and gets no points
+	session.CurrPlayerIdx = 1
+	session.startTurn()
+	if !session.skip() {
+		t.Fatalf("expected the active turn to be skipped")
+	}
+
+	if userID := <-session.passCh; userID != 2 {
+		t.Fatalf("expected the turn of 2 to be passed, got %d", userID)
+	}
+
+	if !session.takeSkip() {
+		t.Fatalf("expected the skip to be taken")
+	}
+
+	if session.skip() {
+		t.Fatalf("expected the stopped turn not to be skipped")
+	}
+
+	// the author overrides the points of the last turn
+	player := session.Players[1]
+	player.Rates = append(player.Rates, &model.Rate{Points: 10, Completed: true})
+	session.lastTurn = player
+	if err := session.requestPoints(1); err != nil {
+		t.Fatalf("request points: %v", err)
+	}
+
+	if ok, _ := session.overridePoints(2, 2, "3"); ok {
+		t.Fatalf("expected the points of another user to be ignored")
+	}
+
+	if ok, _ := session.overridePoints(1, 1, "lots"); !ok || player.Rates[0].Points != 10 {
+		t.Fatalf("expected the invalid points to be rejected")
+	}
+
+	if ok, _ := session.overridePoints(1, 1, "0"); ok {
+		t.Fatalf("expected the invalid points to cancel the change")
+	}
+
+	// the points are sent for the turn named in the request even if another turn has finished since
+	if err := session.requestPoints(1); err != nil {
+		t.Fatalf("request points: %v", err)
+	}
+
+	session.lastTurn = session.Players[0]
+	session.Players[0].Rates = append(session.Players[0].Rates, &model.Rate{Points: 7, Completed: true})
+	if ok, _ := session.overridePoints(1, 1, "0"); !ok || player.Rates[0].Points != 0 || player.Rates[0].Completed {
+		t.Fatalf("expected the points to be overridden, got %+v", player.Rates[0])
+	}
+
+	if ok, _ := session.overridePoints(1, 1, "5"); ok || session.Players[0].Rates[0].Points != 7 {
+		t.Fatalf("expected the points to be overridden once")
+	}
+
+	// the next turn cancels the change of the points
+	if err := session.requestPoints(1); err != nil {
+		t.Fatalf("request points: %v", err)
+	}
+
+	session.startTurn()
+	if ok, _ := session.overridePoints(1, 1, "5"); ok {
+		t.Fatalf("expected the change of the points to be cancelled by the next turn")
+	}
+
+	// the author kicks the player
+	if err := session.kick(2); err != nil {
+		t.Fatalf("kick: %v", err)
+	}
+
+	if session.Players[2].IsPlaying() || len(kicked) != 1 || kicked[0] != session.Players[2] {
+		t.Fatalf("expected the player to be kicked")
+	}
+
+	if n := session.AlivePlayersLen(); n != 2 {
+		t.Errorf("expected 2 alive players, got %d", n)
+	}
+}
+
+func TestSessionMaxTurnPoints(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		typed    bool
+		expected int
+	}{
+		{name: "timer", expected: 30 + 15 + 10},
+		{name: "typed_answers", typed: true, expected: 2*resource.AnswerPoints + 10},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			session := newTestSession(transport.NewRecorder(), 1)
+			session.Config.TypedAnswers = tc.typed
+			session.Config.Categories = []string{"fruit", "city"}
+			session.Config.Bloopses = []resource.Bloops{{Points: 10, Seconds: -5}, {Points: 5, Seconds: 15}}
+			if max := session.maxTurnPoints(); max != tc.expected {
+				t.Errorf("expected %d max points, got %d", tc.expected, max)
+			}
+		})
+	}
+}
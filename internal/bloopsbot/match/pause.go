@@ -0,0 +1,136 @@
+package match
+
+import (
+	"context"
+	"time"
+)
+
+// timer is a time.Timer that can be frozen while the game is paused
+type timer struct {
+	*time.Timer
+	deadline time.Time
+	// the time left when the timer was frozen, negative if the timer had already fired or stopped
+	left time.Duration
+}
+
+func newTimer(d time.Duration) *timer {
+	return &timer{Timer: time.NewTimer(d), deadline: time.Now().Add(d)}
+}
+
+func (t *timer) freeze() {
+	t.left = -1
+	if t.Stop() {
+		t.left = time.Until(t.deadline)
+	}
+}
+
+func (t *timer) unfreeze() {
+	if t.left < 0 {
+		return
+	}
+
+	t.deadline = time.Now().Add(t.left)
+	t.Reset(t.left)
+}
+
+// pause freezes the timers of the running game and the playing timeout
+func (r *Session) pause() {
+	r.mtx.Lock()
+	if r.State != StateKindPlaying && r.State != StateKindProcessing {
+		r.mtx.Unlock()
+		return
+	}
+
+	r.pausedState = r.State
+	r.State = StateKindPaused
+	r.pausedAt = time.Now()
+	r.resumeCh = make(chan struct{})
+	close(r.pauseCh)
+	if r.deadline != nil {
+		r.deadline.Stop()
+	}
+	r.mtx.Unlock()
+
+	r.syncBroadcast(r.lang.TextGamePausedMsg)
+}
+
+// resume continues the paused game from where it stopped
+func (r *Session) resume() {
+	r.mtx.Lock()
+	if r.State != StateKindPaused {
+		r.mtx.Unlock()
+		return
+	}
+
+	r.State = r.pausedState
+	r.PausedFor += time.Since(r.pausedAt)
+	r.pauseCh = make(chan struct{})
+	close(r.resumeCh)
+	if r.deadline != nil {
+		r.deadline.Reset(r.timeout - (time.Since(r.CreatedAt) - r.PausedFor))
+	}
+	r.mtx.Unlock()
+
+	r.syncBroadcast(r.lang.TextGameUnpausedMsg)
+}
+
+func (r *Session) isPaused() bool {
+	return r.getState() == StateKindPaused
+}
+
+// pauseSignal returns the channel closed when the game is paused
+func (r *Session) pauseSignal() <-chan struct{} {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return r.pauseCh
+}
+
+// waitResumed blocks while the game is paused, the timers are frozen until the resume
+func (r *Session) waitResumed(ctx context.Context, timers ...*timer) error {
+	r.mtx.RLock()
+	paused := r.State == StateKindPaused
+	resumeCh := r.resumeCh
+	r.mtx.RUnlock()
+	if !paused {
+		return nil
+	}
+
+	for _, t := range timers {
+		t.freeze()
+	}
+
+	select {
+	case <-resumeCh:
+	case <-ctx.Done():
+		return ErrContextFatalClosed
+	}
+
+	for _, t := range timers {
+		t.unfreeze()
+	}
+
+	return nil
+}
+
+// activeTime returns how long the game has been running without the pauses
+func (r *Session) activeTime() time.Duration {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	d := time.Since(r.CreatedAt) - r.PausedFor
+	if r.State == StateKindPaused {
+		d -= time.Since(r.pausedAt)
+	}
+
+	return d
+}
+
+// RunningState returns the state of the game, the state the game resumes to if it is paused.
+// Must be called under the lock
+func (r *Session) RunningState() uint8 {
+	if r.State == StateKindPaused {
+		return r.pausedState
+	}
+
+	return r.State
+}
@@ -0,0 +1,105 @@
+package match
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+)
+
+func TestSessionPause(t *testing.T) {
+	t.Parallel()
+
+	session := newTestSession(transport.NewRecorder(), 1, 2)
+	session.State = StateKindPlaying
+
+	session.pause()
+	if !session.isPaused() {
+		t.Fatalf("expected the game to be paused")
+	}
+
+	select {
+	case <-session.pauseSignal():
+	default:
+		t.Fatalf("expected the pause to be signaled")
+	}
+
+	// the game moves to the next round while paused
+	session.ChangeState(StateKindProcessing)
+	if state := session.getState(); state != StateKindPaused {
+		t.Fatalf("expected the paused state, got %d", state)
+	}
+
+	if state := session.RunningState(); state != StateKindProcessing {
+		t.Fatalf("expected the processing state after the resume, got %d", state)
+	}
+
+	tm := newTimer(50 * time.Millisecond)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- session.waitResumed(context.Background(), tm)
+	}()
+
+	time.Sleep(100 * time.Millisecond)
+	select {
+	case err := <-errCh:
+		t.Fatalf("expected the game to wait for the resume, got %v", err)
+	case <-tm.C:
+		t.Fatalf("expected the timer to be frozen")
+	default:
+	}
+
+	session.resume()
+	if err := <-errCh; err != nil {
+		t.Fatalf("wait resumed: %v", err)
+	}
+
+	if state := session.getState(); state != StateKindProcessing {
+		t.Fatalf("expected the processing state, got %d", state)
+	}
+
+	if session.PausedFor < 100*time.Millisecond {
+		t.Errorf("expected the pause to be counted, got %s", session.PausedFor)
+	}
+
+	select {
+	case <-tm.C:
+	case <-time.After(time.Second):
+		t.Fatalf("expected the timer to fire after the resume")
+	}
+
+	select {
+	case <-session.pauseSignal():
+		t.Fatalf("expected the pause signal to be reset")
+	default:
+	}
+}
+
+func TestSessionExpired(t *testing.T) {
+	t.Parallel()
+
+	session := newTestSession(transport.NewRecorder(), 1, 2)
+	session.CreatedAt = time.Now().Add(-2 * time.Minute)
+
+	expired := make(chan struct{})
+	session.Config.ExpireFn = func(*Session) error {
+		close(expired)
+		return nil
+	}
+	session.doneFn = func(*Session) error {
+		t.Error("expected the expired game not to be done")
+		return nil
+	}
+	session.warnFn = func(*Session) error {
+		t.Error("expected the expired game not to be saved")
+		return nil
+	}
+
+	session.Run(context.Background())
+	select {
+	case <-expired:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the expired game to be cleaned up")
+	}
+}
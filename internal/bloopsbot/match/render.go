@@ -3,6 +3,7 @@ package match
 import (
 	"fmt"
 	"strconv"
+	"strings"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
 	"github.com/bloops-games/bloops/internal/database/matchstate/model"
@@ -19,6 +20,98 @@ func thumbDownButton(n int) tgbotapi.InlineKeyboardButton {
 	return tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("%d %s", n, resource.TextThumbDown), resource.TextThumbDown)
 }
 
+func abstainButton(n int) tgbotapi.InlineKeyboardButton {
+	return tgbotapi.NewInlineKeyboardButtonData(fmt.Sprintf("%d %s", n, resource.TextAbstain), resource.TextAbstain)
+}
+
+// rejectAnswerButton toggles the rejection of the typed answer
+func rejectAnswerButton(n, idx int, word string) tgbotapi.InlineKeyboardButton {
+	return tgbotapi.NewInlineKeyboardButtonData(
+		fmt.Sprintf("%d %s %s", n, emoji.CrossMark.String(), word),
+		resource.RejectAnswerPrefix+strconv.Itoa(idx),
+	)
+}
+
+// parseRejectAnswerData returns the index of the typed answer by the button data
+func parseRejectAnswerData(data string) (int, bool) {
+	if !strings.HasPrefix(data, resource.RejectAnswerPrefix) {
+		return 0, false
+	}
+
+	idx, err := strconv.Atoi(strings.TrimPrefix(data, resource.RejectAnswerPrefix))
+	if err != nil {
+		return 0, false
+	}
+
+	return idx, true
+}
+
+// ballots by the vote button data
+var ballotsData = map[string]model.Ballot{
+	resource.TextThumbUp:   model.BallotThumbUp,
+	resource.TextThumbDown: model.BallotThumbDown,
+	resource.TextAbstain:   model.BallotAbstain,
+}
+
+// renderVoteMsg renders the vote question with the typed answers of the round
+func (r *Session) renderVoteMsg(rate *model.Rate) string {
+	buf := strpool.Get()
+	defer func() {
+		buf.Reset()
+		strpool.Put(buf)
+	}()
+
+	buf.WriteString(r.lang.TextVoteMsg)
+	if len(rate.Answers) > 0 {
+		buf.WriteString("\n")
+	}
+
+	for _, answer := range rate.Answers {
+		mark := emoji.QuestionMark.String()
+		if answer.Known {
+			mark = emoji.CheckMarkButton.String()
+		}
+
+		_, _ = fmt.Fprintf(buf, "\n%s %s: %s", mark, answer.Category, answer.Word)
+	}
+
+	if len(rate.Answers) > knownAnswersNum(rate.Answers) {
+		buf.WriteString("\n\n")
+		buf.WriteString(r.lang.TextVoteAnswersHint)
+	}
+
+	return buf.String()
+}
+
+// renderRejectAnswer renders the answer to the voter who rejected the typed answer or took the rejection back
+func (r *Session) renderRejectAnswer(rate *model.Rate, idx int, rejected bool) string {
+	if rejected {
+		return fmt.Sprintf(r.lang.TextAnswerRejected, rate.Answers[idx].Word)
+	}
+
+	return fmt.Sprintf(r.lang.TextAnswerRestored, rate.Answers[idx].Word)
+}
+
+// renderVoteButtons renders the ballot buttons with the number of votes and a button to reject
+// every unknown typed answer, must be called under the lock
+func (r *Session) renderVoteButtons() tgbotapi.InlineKeyboardMarkup {
+	rows := [][]tgbotapi.InlineKeyboardButton{
+		tgbotapi.NewInlineKeyboardRow(
+			thumbUpButton(r.activeVote.count(model.BallotThumbUp)),
+			thumbDownButton(r.activeVote.count(model.BallotThumbDown)),
+			abstainButton(r.activeVote.count(model.BallotAbstain)),
+		),
+	}
+
+	for i, answer := range r.activeVote.answers {
+		if !answer.Known {
+			rows = append(rows, tgbotapi.NewInlineKeyboardRow(rejectAnswerButton(r.activeVote.countRejects(i), i, answer.Word)))
+		}
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(rows...)
+}
+
 func (r *Session) renderDropBloopsMsg(bloops *resource.Bloops) string {
 	buf := strpool.Get()
 	defer func() {
@@ -26,7 +119,7 @@ func (r *Session) renderDropBloopsMsg(bloops *resource.Bloops) string {
 		strpool.Put(buf)
 	}()
 
-	_, _ = fmt.Fprintf(buf, "%s *БЛЮПС!*\n\n", emoji.PartyPopper.String())
+	_, _ = fmt.Fprintf(buf, r.lang.TextDropBloopsTitle, emoji.PartyPopper.String())
 	_, _ = fmt.Fprintf(buf, "*%s*\n", bloops.Name)
 	_, _ = fmt.Fprintf(buf, "%s\n\n", bloops.Task)
 	_, _ = fmt.Fprintf(buf, "%s ", emoji.HundredPoints.String())
@@ -37,14 +130,16 @@ func (r *Session) renderDropBloopsMsg(bloops *resource.Bloops) string {
 		buf.WriteString(strconv.Itoa(bloops.Points))
 	}
 
-	_, _ = fmt.Fprintf(buf, " очков\n%s ", emoji.Stopwatch.String())
+	_, _ = fmt.Fprintf(buf, " %s\n%s ", r.lang.Plural(bloops.Points, r.lang.NounPoints), emoji.Stopwatch.String())
 
 	if bloops.Seconds >= 0 {
 		_, _ = fmt.Fprintf(buf, "+%s", strconv.Itoa(bloops.Seconds))
 	} else {
 		buf.WriteString(strconv.Itoa(bloops.Seconds))
 	}
-	buf.WriteString(" сек\n\nРасскажи о блюпсе игрокам и постарайся выполнить")
+	buf.WriteString(" ")
+	buf.WriteString(r.lang.TextSecondsShort)
+	buf.WriteString(r.lang.TextDropBloopsFooter)
 
 	return buf.String()
 }
@@ -56,12 +151,19 @@ func (r *Session) renderStartMsg() string {
 		strpool.Put(buf)
 	}()
 
-	_, _ = fmt.Fprintf(buf, "%s Готов сыграть?\n\n", emoji.GameDie.String())
-	_, _ = fmt.Fprintf(buf, "Нужно назвать все слова из списка категорий на выпавшую букву\n\n")
-	_, _ = fmt.Fprintf(buf, "%s %s слов\n", emoji.Pen.String(), strconv.Itoa(len(r.Config.Categories)))
-	_, _ = fmt.Fprintf(buf, "%s %s секунд\n\n", emoji.Stopwatch.String(), strconv.Itoa(r.currRoundSeconds))
-	_, _ = fmt.Fprintf(buf, "%s Категории:\n\n", emoji.CardIndex.String())
-	_, _ = fmt.Fprintf(buf, "%s\n\n%s", r.renderCategories(), resource.TextClickStartBtnMsg)
+	wordsNum := len(r.Config.Categories)
+	_, _ = fmt.Fprintf(buf, r.lang.TextStartRoundTitle, emoji.GameDie.String())
+	buf.WriteString(r.lang.TextStartRoundTask)
+	_, _ = fmt.Fprintf(buf, "%s %d %s\n", emoji.Pen.String(), wordsNum, r.lang.Plural(wordsNum, r.lang.NounWords))
+	_, _ = fmt.Fprintf(
+		buf,
+		"%s %d %s\n\n",
+		emoji.Stopwatch.String(),
+		r.currRoundSeconds,
+		r.lang.Plural(r.currRoundSeconds, r.lang.NounSeconds),
+	)
+	_, _ = fmt.Fprintf(buf, r.lang.TextCategoriesTitle, emoji.CardIndex.String())
+	_, _ = fmt.Fprintf(buf, "%s\n\n%s", r.renderCategories(), r.lang.TextClickStartBtnMsg)
 
 	return buf.String()
 }
@@ -73,15 +175,29 @@ func (r *Session) renderGameFavorites(favorites []PlayerScore) string {
 		strpool.Put(buf)
 	}()
 
-	_, _ = fmt.Fprintf(buf, "%s Игра завершена\n\n*Список победителей*\n\n", emoji.ChequeredFlag.String())
+	_, _ = fmt.Fprintf(buf, r.lang.TextGameFinishedTitle, emoji.ChequeredFlag.String())
+
+	if r.Config.IsTeams() {
+		for _, team := range r.winningTeams() {
+			_, _ = fmt.Fprintf(
+				buf,
+				"%s *%s* - %d %s\n",
+				emoji.SportsMedal.String(),
+				team.Name,
+				team.Points,
+				r.lang.Plural(team.Points, r.lang.NounPoints),
+			)
+		}
+	}
 
 	for _, score := range favorites {
 		_, _ = fmt.Fprintf(
 			buf,
-			"%s %s - %s очков\n",
+			"%s %s - %d %s\n",
 			emoji.SportsMedal.String(),
 			score.Player.FormatFirstName(),
-			strconv.Itoa(score.Points),
+			score.Points,
+			r.lang.Plural(score.Points, r.lang.NounPoints),
 		)
 	}
 
@@ -95,7 +211,7 @@ func (r *Session) renderScores() string {
 		strpool.Put(buf)
 	}()
 
-	_, _ = fmt.Fprintf(buf, "%s %s", emoji.Trophy.String(), resource.TextLeaderboardHeader)
+	_, _ = fmt.Fprintf(buf, "%s %s", emoji.Trophy.String(), r.lang.TextLeaderboardHeader)
 
 	medalIcon := func(n int) string {
 		var medal string
@@ -111,14 +227,43 @@ func (r *Session) renderScores() string {
 		return medal
 	}
 
+	if r.Config.IsTeams() {
+		for n, team := range r.TeamScores() {
+			_, _ = fmt.Fprintf(
+				buf,
+				"%s. %s*%s*, %d %s\n",
+				strconv.Itoa(n+1),
+				medalIcon(n),
+				team.Name,
+				team.Points,
+				r.lang.Plural(team.Points, r.lang.NounPoints),
+			)
+
+			for _, cell := range team.Members {
+				_, _ = fmt.Fprintf(
+					buf,
+					r.lang.TextTeamMember,
+					cell.Player.FormatFirstName(),
+					cell.Points,
+					r.lang.Plural(cell.Points, r.lang.NounPoints),
+					len(cell.Player.Rates),
+					r.Config.RoundsNum,
+				)
+			}
+		}
+
+		return buf.String()
+	}
+
 	for n, cell := range r.Scores() {
 		_, _ = fmt.Fprintf(
 			buf,
-			"%s. %s*%s*, %s очков, %s/%s\n",
+			"%s. %s*%s*, %d %s, %s/%s\n",
 			strconv.Itoa(n+1),
 			medalIcon(n),
 			cell.Player.FormatFirstName(),
-			strconv.Itoa(cell.Points),
+			cell.Points,
+			r.lang.Plural(cell.Points, r.lang.NounPoints),
 			strconv.Itoa(len(cell.Player.Rates)),
 			strconv.Itoa(r.Config.RoundsNum),
 		)
@@ -150,32 +295,72 @@ func (r *Session) renderSetting() string {
 		buf.Reset()
 		strpool.Put(buf)
 	}()
-	_, _ = fmt.Fprintf(buf, "%s *Параметры*\n\n", emoji.Gear.String())
+	_, _ = fmt.Fprintf(buf, r.lang.TextSettingsTitle, emoji.Gear.String())
 	_, _ = fmt.Fprintf(
 		buf,
-		"%s  Количество раундов: %s\n",
+		r.lang.TextSettingsRoundsNum,
 		emoji.ChequeredFlag.String(),
 		strconv.Itoa(r.Config.RoundsNum),
 	)
-	_, _ = fmt.Fprintf(buf, "%s Время раунда: %s сек\n", emoji.Stopwatch.String(), strconv.Itoa(r.Config.RoundTime))
-	_, _ = fmt.Fprintf(buf, "%s Блюпсы: ", emoji.GemStone.String())
+	_, _ = fmt.Fprintf(buf, r.lang.TextSettingsRoundTime, emoji.Stopwatch.String(), strconv.Itoa(r.Config.RoundTime))
+	_, _ = fmt.Fprintf(buf, r.lang.TextSettingsBloops, emoji.GemStone.String())
 
 	if len(r.Config.Bloopses) > 0 {
-		buf.WriteString("да")
+		buf.WriteString(r.lang.TextYes)
+		_, _ = fmt.Fprintf(buf, " (%d%%)", r.Config.BloopsChance)
 	} else {
-		buf.WriteString("нет")
+		buf.WriteString(r.lang.TextNo)
 	}
 	buf.WriteString("\n")
-	_, _ = fmt.Fprintf(buf, "%s Голосование: ", emoji.Loudspeaker.String())
+	_, _ = fmt.Fprintf(buf, r.lang.TextSettingsVote, emoji.Loudspeaker.String())
 
 	if r.Config.Vote {
-		buf.WriteString("да")
+		buf.WriteString(r.lang.TextYes)
+		_, _ = fmt.Fprintf(
+			buf,
+			" (%s, %d %s)",
+			r.lang.VoteRuleText(r.Config.VoteRule),
+			r.Config.VoteTimeout,
+			r.lang.Plural(r.Config.VoteTimeout, r.lang.NounSeconds),
+		)
+		if r.Config.SpectatorsVote {
+			buf.WriteString(r.lang.TextSettingsSpectators)
+		}
+
+		if r.Config.OfflineVote {
+			buf.WriteString(r.lang.TextSettingsOffline)
+		}
 	} else {
-		buf.WriteString("нет")
+		buf.WriteString(r.lang.TextNo)
+	}
+
+	buf.WriteString("\n")
+	_, _ = fmt.Fprintf(buf, r.lang.TextSettingsAnswers, emoji.Keyboard.String())
+	if r.Config.TypedAnswers {
+		buf.WriteString(r.lang.TextYes)
+	} else {
+		buf.WriteString(r.lang.TextNo)
+	}
+
+	if r.Config.Private {
+		buf.WriteString("\n")
+		_, _ = fmt.Fprintf(buf, r.lang.TextSettingsPrivate, emoji.Locked.String())
+		buf.WriteString(r.lang.TextYes)
+	}
+
+	if r.Config.Public {
+		buf.WriteString("\n")
+		_, _ = fmt.Fprintf(buf, r.lang.TextSettingsPublic, emoji.GlobeWithMeridians.String(), r.Config.MaxPlayers)
+	}
+
+	if r.Config.IsTeams() {
+		buf.WriteString("\n")
+		_, _ = fmt.Fprintf(buf, r.lang.TextSettingsTeams, emoji.Family.String())
+		buf.WriteString(strings.Join(r.Config.Teams, ", "))
 	}
 
 	buf.WriteString("\n\n")
-	_, _ = fmt.Fprintf(buf, "%s Категории\n", emoji.CardIndex.String())
+	_, _ = fmt.Fprintf(buf, r.lang.TextSettingsCategories, emoji.CardIndex.String())
 	buf.WriteString(r.renderCategories())
 
 	return buf.String()
@@ -189,13 +374,15 @@ func (r *Session) renderPlayers() string {
 		strpool.Put(buf)
 	}()
 
-	for i, player := range r.Players {
-		buf.WriteString(player.FormatFirstName())
-		if i < len(r.Players)-1 {
-			buf.WriteString(",")
+	var names []string
+	for _, player := range r.Players {
+		if !player.Spectator {
+			names = append(names, player.FormatFirstName())
 		}
 	}
 
+	buf.WriteString(strings.Join(names, ","))
+
 	return buf.String()
 }
 
@@ -206,7 +393,7 @@ func (r *Session) renderPlayerGetPoints(player *model.Player, points int) string
 		strpool.Put(buf)
 	}()
 
-	_, _ = fmt.Fprintf(buf, "%s набирает %s очков", player.FormatFirstName(), strconv.Itoa(points))
+	_, _ = fmt.Fprintf(buf, r.lang.TextPlayerGetPoints, player.FormatFirstName(), points, r.lang.Plural(points, r.lang.NounPoints))
 
 	return buf.String()
 }
@@ -218,9 +405,9 @@ func (r *Session) renderStartHelpMsg(player *model.Player, sentLetter string) st
 		strpool.Put(buf)
 	}()
 
-	_, _ = fmt.Fprintf(buf, "Игрок  %s должен назвать слова:\n\n", player.FormatFirstName())
+	_, _ = fmt.Fprintf(buf, r.lang.TextStartHelpMsg, player.FormatFirstName())
 	_, _ = fmt.Fprintf(buf, "%s\n\n", r.renderCategories())
-	_, _ = fmt.Fprintf(buf, "На букву: *%s*", sentLetter)
+	_, _ = fmt.Fprintf(buf, r.lang.TextStartHelpLetter, sentLetter)
 
 	return buf.String()
 }
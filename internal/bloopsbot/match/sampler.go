@@ -0,0 +1,112 @@
+package match
+
+import (
+	"math"
+	"math/rand"
+	"sync"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+)
+
+// Rand is a source of randomness of the game, *rand.Rand satisfies it
+type Rand interface {
+	Intn(n int) int
+	Float64() float64
+}
+
+// countingSource counts the values drawn from the seeded source,
+// the resumed game skips the drawn values to continue the sequence where it stopped
+type countingSource struct {
+	src   rand.Source
+	draws int64
+}
+
+func newCountingSource(seed, skip int64) *countingSource {
+	s := &countingSource{src: rand.NewSource(seed)}
+	for s.draws < skip {
+		s.Int63()
+	}
+
+	return s
+}
+
+func (s *countingSource) Int63() int64 {
+	s.draws++
+	return s.src.Int63()
+}
+
+func (s *countingSource) Seed(seed int64) {
+	s.src.Seed(seed)
+	s.draws = 0
+}
+
+func newSeedRand(src *countingSource) Rand {
+	return rand.New(src) // nolint
+}
+
+// lockedRand makes the source of randomness safe for concurrent use
+type lockedRand struct {
+	mtx sync.Mutex
+	rnd Rand
+	// nil if the randomness is not drawn from the seed
+	src *countingSource
+}
+
+// draws returns the number of the values drawn from the seeded source
+func (l *lockedRand) draws() int64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if l.src == nil {
+		return 0
+	}
+
+	return l.src.draws
+}
+
+func (l *lockedRand) Intn(n int) int {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.rnd.Intn(n)
+}
+
+func (l *lockedRand) Float64() float64 {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	return l.rnd.Float64()
+}
+
+// sampleWeighted selects a bloops with the probability proportional to its weight using Efraimidis–Spirakis
+// sampling: every item gets the key u^(1/w) and the item with the largest key wins.
+// The bloopses with names from the exclude set are skipped
+func sampleWeighted(rnd Rand, bloopses []resource.Bloops, exclude map[string]struct{}) (resource.Bloops, bool) {
+	var (
+		result resource.Bloops
+		found  bool
+		maxKey = -1.0
+	)
+
+	for _, bloops := range bloopses {
+		if _, ok := exclude[bloops.Name]; ok {
+			continue
+		}
+
+		weight := bloops.Weight
+		if weight <= 0 {
+			weight = 1
+		}
+
+		key := math.Pow(rnd.Float64(), 1/float64(weight))
+		if key > maxKey {
+			maxKey = key
+			result = bloops
+			found = true
+		}
+	}
+
+	return result, found
+}
+
+// roll returns true with the given chance in percent
+func roll(rnd Rand, chance int) bool {
+	return rnd.Intn(100) < chance
+}
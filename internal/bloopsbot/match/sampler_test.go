@@ -0,0 +1,54 @@
+package match
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+)
+
+func TestSampleWeighted(t *testing.T) {
+	t.Parallel()
+
+	bloopses := []resource.Bloops{
+		{Name: "light", Weight: 1},
+		{Name: "heavy", Weight: 3},
+	}
+
+	rnd := rand.New(rand.NewSource(42)) // nolint
+	counts := map[string]int{}
+	for i := 0; i < 10000; i++ {
+		bloops, ok := sampleWeighted(rnd, bloopses, nil)
+		if !ok {
+			t.Fatal("sampleWeighted: expected a bloops")
+		}
+		counts[bloops.Name]++
+	}
+
+	if ratio := float64(counts["heavy"]) / float64(counts["light"]); ratio < 2.7 || ratio > 3.3 {
+		t.Errorf("sampleWeighted: expected the heavy to light ratio close to 3, got %.2f", ratio)
+	}
+
+	bloops, ok := sampleWeighted(rnd, bloopses, map[string]struct{}{"heavy": {}})
+	if !ok || bloops.Name != "light" {
+		t.Errorf("sampleWeighted: expected light, got %s", bloops.Name)
+	}
+
+	if _, ok := sampleWeighted(rnd, bloopses, map[string]struct{}{"heavy": {}, "light": {}}); ok {
+		t.Error("sampleWeighted: expected nothing when every bloops is excluded")
+	}
+}
+
+func TestSampleWeightedSeeded(t *testing.T) {
+	t.Parallel()
+
+	bloopses := []resource.Bloops{{Name: "a", Weight: 1}, {Name: "b", Weight: 2}, {Name: "c", Weight: 5}}
+	first, second := rand.New(rand.NewSource(7)), rand.New(rand.NewSource(7)) // nolint
+	for i := 0; i < 100; i++ {
+		a, _ := sampleWeighted(first, bloopses, nil)
+		b, _ := sampleWeighted(second, bloopses, nil)
+		if a.Name != b.Name {
+			t.Fatalf("sampleWeighted: expected the same draws for the same seed, got %s and %s", a.Name, b.Name)
+		}
+	}
+}
@@ -4,29 +4,24 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"math"
-	"math/rand"
 	"runtime"
 	"sort"
 	"sync"
 	"time"
 
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	"github.com/bloops-games/bloops/internal/bloopsbot/util"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
 	"github.com/bloops-games/bloops/internal/database/matchstate/model"
 	"github.com/bloops-games/bloops/internal/logging"
-	"github.com/enescakir/emoji"
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
-	"github.com/valyala/fastrand"
 )
 
-const bloopsMaxWeight = 3
-
 const (
 	generateLetterTimes      = 10
 	defaultInactiveFatalTime = 600
 	defaultInactiveWarnTime  = 500
-	defaultInactiveVoteTime  = 30
 )
 
 type QueryCallbackHandlerFn func(query *tgbotapi.CallbackQuery) error
@@ -36,15 +31,22 @@ const (
 	StateKindPlaying
 	StateKindProcessing
 	StateKindFinished
+	StateKindPaused
 )
 
 var (
 	ErrContextFatalClosed = fmt.Errorf("context closed")
 	ErrValidation         = fmt.Errorf("validation errors")
+	ErrGameFull           = fmt.Errorf("game is full")
 )
 
-func newVote() *vote {
-	return &vote{pub: make(chan struct{}, 1)}
+func newVote(player *model.Player) *vote {
+	return &vote{
+		player:  player,
+		ballots: map[*model.Player]model.Ballot{},
+		rejects: map[*model.Player]map[int]bool{},
+		pub:     make(chan struct{}, 1),
+	}
 }
 
 type PlayerScore struct {
@@ -57,27 +59,128 @@ type PlayerScore struct {
 }
 
 type vote struct {
-	thumbUp   int
-	thumbDown int
-	pub       chan struct{}
+	// the player whose round is voted on
+	player *model.Player
+	// the last ballot of every voter, the offline players vote through their host
+	ballots map[*model.Player]model.Ballot
+	// the typed answers of the round, the unknown ones are voted on word by word
+	answers []model.Answer
+	// the indexes of the answers rejected by every voter
+	rejects map[*model.Player]map[int]bool
+	// all the ballots in the order they were cast
+	trail []model.Vote
+	// the voting is over, the ballots are not accepted
+	done bool
+	pub  chan struct{}
+}
+
+func (v *vote) count(ballot model.Ballot) int {
+	var n int
+	for _, b := range v.ballots {
+		if b == ballot {
+			n++
+		}
+	}
+
+	return n
+}
+
+// countRejects returns the number of the voters who rejected the answer
+func (v *vote) countRejects(idx int) int {
+	var n int
+	for _, rejected := range v.rejects {
+		if rejected[idx] {
+			n++
+		}
+	}
+
+	return n
+}
+
+// answerBallots counts the ballots on the answer: the voter who rejected the answer or the whole round
+// is against it, the voter who approved the round and kept the answer is for it
+func (v *vote) answerBallots(idx int) (thumbUp, thumbDown int) {
+	voters := map[*model.Player]struct{}{}
+	for voter := range v.ballots {
+		voters[voter] = struct{}{}
+	}
+
+	for voter := range v.rejects {
+		voters[voter] = struct{}{}
+	}
+
+	for voter := range voters {
+		switch {
+		case v.rejects[voter][idx] || v.ballots[voter] == model.BallotThumbDown:
+			thumbDown++
+		case v.ballots[voter] == model.BallotThumbUp:
+			thumbUp++
+		}
+	}
+
+	return thumbUp, thumbDown
+}
+
+// resolveAnswers marks the rejected answers and returns the number of the accepted ones,
+// the known answers are accepted whatever the vote
+func (v *vote) resolveAnswers(rule resource.VoteRule) int {
+	var n int
+	for i := range v.answers {
+		if !v.answers[i].Known {
+			thumbUp, thumbDown := v.answerBallots(i)
+			v.answers[i].Rejected = !answerAccepted(rule, thumbUp, thumbDown)
+		}
+
+		if !v.answers[i].Rejected {
+			n++
+		}
+	}
+
+	return n
 }
 
 func NewSession(config Config) *Session {
+	if config.Seed == 0 {
+		config.Seed = time.Now().UnixNano()
+	}
+
+	var src *countingSource
+	if config.Rand == nil {
+		src = newCountingSource(config.Seed, config.Draws)
+		config.Rand = newSeedRand(src)
+	}
+
+	if config.BloopsChance == 0 {
+		config.BloopsChance = resource.DefaultBloopsChance
+	}
+
+	if config.VoteRule == 0 {
+		config.VoteRule = resource.DefaultVoteRule
+	}
+
+	if config.VoteTimeout == 0 {
+		config.VoteTimeout = resource.DefaultVoteTimeout
+	}
+
 	return &Session{
-		Config:      config,
-		tg:          config.Tg,
-		Code:        config.Code,
-		stateCh:     make(chan uint8, 1),
-		sndCh:       make(chan tgbotapi.Chattable, 10),
-		startCh:     make(chan struct{}, 1),
-		stopCh:      make(chan struct{}, 1),
-		passCh:      make(chan int64, 1),
-		State:       StateKindWaiting,
-		msgCallback: map[int]QueryCallbackHandlerFn{},
-		doneFn:      config.DoneFn,
-		warnFn:      config.WarnFn,
-		timeout:     config.Timeout,
-		CreatedAt:   time.Now(),
+		Config:        config,
+		lang:          resource.Lang(config.Locale),
+		rnd:           &lockedRand{rnd: config.Rand, src: src},
+		tg:            config.Tg,
+		Code:          config.Code,
+		stateCh:       make(chan uint8, 1),
+		sndCh:         make(chan transport.Message, 10),
+		startCh:       make(chan struct{}, 1),
+		stopCh:        make(chan struct{}, 1),
+		passCh:        make(chan int64, 1),
+		pauseCh:       make(chan struct{}),
+		State:         StateKindWaiting,
+		CurrPlayerIdx: -1,
+		msgCallback:   map[int]QueryCallbackHandlerFn{},
+		doneFn:        config.DoneFn,
+		warnFn:        config.WarnFn,
+		timeout:       config.Timeout,
+		CreatedAt:     time.Now(),
 	}
 }
 
@@ -86,15 +189,21 @@ type Session struct {
 
 	Code      int64
 	CreatedAt time.Time
+	// total time the game has been paused, the playing timeout does not run while paused
+	PausedFor time.Duration
 
-	tg      *tgbotapi.BotAPI
+	tg      transport.Transport
+	lang    *resource.Catalog
+	rnd     *lockedRand
 	stateCh chan uint8
 
 	mtx          sync.RWMutex
 	msgCallback  map[int]QueryCallbackHandlerFn
 	Players      []*model.Player
 	CurrRoundIdx int
-	State        uint8
+	// index of the player whose turn is in progress, -1 between turns
+	CurrPlayerIdx int
+	State         uint8
 
 	currRoundSeconds int
 	bloopsPoints     int
@@ -105,31 +214,94 @@ type Session struct {
 	warnFn func(session *Session) error
 	cancel func()
 
-	sndCh      chan tgbotapi.Chattable
+	sndCh      chan transport.Message
 	startCh    chan struct{}
 	stopCh     chan struct{}
 	passCh     chan int64
 	sema       sync.Once
 	activeVote *vote
+	// answers of the active player, nil if the player is not typing them now
+	turnAnswers *turnAnswers
+
+	// the author moderation, see moderation.go
+	skippable bool
+	skipTurn  bool
+	// the turn the author is sending the new points for, nil if the points are not awaited
+	pointsTurn *pointsTurn
+	lastTurn   *model.Player
+
+	// the pause, see pause.go
+	pausedState uint8
+	pausedAt    time.Time
+	pauseCh     chan struct{}
+	resumeCh    chan struct{}
+	deadline    *time.Timer
 }
 
 func (r *Session) Stop() {
 	r.cancel()
 }
 
+// Finish ends the game early, the players get the scores of the played turns
+func (r *Session) Finish() {
+	r.ChangeState(StateKindFinished)
+	r.syncBroadcast(r.renderGameFavorites(r.Favorites()))
+	r.Stop()
+}
+
+// Progress returns the state of the game and the index of the current round
+func (r *Session) Progress() (uint8, int) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	return r.State, r.CurrRoundIdx
+}
+
 func (r *Session) Run(ctx context.Context) {
-	ctx, cancel := context.WithTimeout(ctx, r.timeout)
+	// the timeout is stopped while the game is paused
+	ctx, cancel := context.WithCancel(ctx)
 	r.cancel = cancel
+	r.mtx.Lock()
+	// the restored game keeps the time it has already been played
+	r.deadline = time.AfterFunc(r.timeout-(time.Since(r.CreatedAt)-r.PausedFor), cancel)
+	r.mtx.Unlock()
 	logger := logging.FromContext(ctx)
 	r.sema.Do(func() {
+		if r.Config.Resumed {
+			r.logEvent(r.resumeEvent())
+		} else {
+			r.logEvent(gamelogModel.Event{
+				Kind:      gamelogModel.EventKindStart,
+				Seed:      r.Config.Seed,
+				Locale:    r.Config.Locale,
+				RoundsNum: r.Config.RoundsNum,
+				VoteRule:  uint8(r.Config.VoteRule),
+
+				TypedAnswers: r.Config.TypedAnswers,
+				Teams:        r.Config.Teams,
+			})
+		}
 		go r.loop(ctx)
 		go r.sendingPool(ctx)
 	})
 	logger.Infof("The game session created, code: %d, author: %s", r.Config.Code, r.Config.AuthorName)
 }
 
+// Lang returns the catalog of the game locale
+func (r *Session) Lang() *resource.Catalog {
+	return r.lang
+}
+
+// Favorites returns the players with the most points, in a team game the members of the winning teams
 func (r *Session) Favorites() []PlayerScore {
 	var favorites []PlayerScore
+	if r.Config.IsTeams() {
+		for _, team := range r.winningTeams() {
+			favorites = append(favorites, team.Members...)
+		}
+
+		return favorites
+	}
+
 	var max int
 
 	scores := r.Scores()
@@ -149,15 +321,31 @@ func (r *Session) MoveState(kind uint8) {
 func (r *Session) ChangeState(kind uint8) {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
+	// the points of the finished game can't be changed
+	if kind == StateKindFinished {
+		r.pointsTurn = nil
+	}
+
+	// the paused game takes the state after the resume unless it is finished
+	if r.State == StateKindPaused {
+		if kind != StateKindFinished {
+			r.pausedState = kind
+			return
+		}
+
+		r.PausedFor += time.Since(r.pausedAt)
+	}
+
 	r.State = kind
 }
 
+// AlivePlayersLen returns the number of the players still in the game, the offline players leave with their host
 func (r *Session) AlivePlayersLen() int {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 	var n int
 	for _, player := range r.Players {
-		if player.IsPlaying() && !player.Offline {
+		if player.IsPlaying() && !player.Spectator {
 			n++
 		}
 	}
@@ -182,19 +370,46 @@ func (r *Session) Execute(userID int64, upd tgbotapi.Update) error {
 }
 
 func (r *Session) isPossibleStart(userID int64, cmd string) bool {
-	return r.State == StateKindWaiting && cmd == resource.StartButtonText && r.Config.AuthorID == userID
+	if r.Config.IsGroup() {
+		return r.State == StateKindWaiting && cmd == resource.CmdGroupStart && r.Config.AuthorID == userID
+	}
+
+	return r.State == StateKindWaiting && cmd == r.lang.StartButtonText && r.Config.AuthorID == userID
 }
 
 func (r *Session) executeMessageQuery(userID int64, query *tgbotapi.Message) error {
+	// the answers of the author playing the turn are not taken for the points
+	if r.Config.TypedAnswers && !r.isButtonText(query.Text) {
+		ok, err := r.captureAnswers(userID, query.Text)
+		if err != nil {
+			return fmt.Errorf("capture answers: %w", err)
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	if ok, err := r.overridePoints(userID, query.Chat.ID, query.Text); err != nil || ok {
+		if err != nil {
+			return fmt.Errorf("override points: %w", err)
+		}
+
+		return nil
+	}
+
 	if r.isPossibleStart(userID, query.Text) {
 		if player, ok := r.findPlayer(userID); ok {
-			msg := tgbotapi.NewMessage(player.ChatID, resource.TextGameStarted)
-			msg.ReplyMarkup = tgbotapi.NewReplyKeyboard(
-				tgbotapi.NewKeyboardButtonRow(resource.RatingButton, resource.RulesButton),
-				tgbotapi.NewKeyboardButtonRow(resource.LeaveMenuButton, resource.GameSettingButton),
-			)
-			msg.ParseMode = tgbotapi.ModeMarkdown
-			if _, err := r.tg.Send(msg); err != nil {
+			msg := transport.Message{ChatID: player.ChatID, Text: r.lang.TextGameStarted, Markdown: true}
+			// reply keyboard in a group chat would be shown to everyone
+			if !r.Config.IsGroup() {
+				msg.Keyboard = tgbotapi.NewReplyKeyboard(
+					tgbotapi.NewKeyboardButtonRow(r.lang.RatingButton(), r.lang.RulesButton(), r.lang.ManageButton()),
+					tgbotapi.NewKeyboardButtonRow(r.lang.LeaveButton(), r.lang.GameSettingButton()),
+				)
+			}
+
+			if _, err := r.tg.SendText(msg); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 		}
@@ -203,26 +418,33 @@ func (r *Session) executeMessageQuery(userID int64, query *tgbotapi.Message) err
 			return fmt.Errorf("send start sticker: %w", err)
 		}
 
-		r.asyncBroadcast(resource.TextGameStarted, userID)
+		// the author has already got the same message in the group chat
+		if !r.Config.IsGroup() {
+			r.asyncBroadcast(r.lang.TextGameStarted, userID)
+		}
 
 		r.stateCh <- StateKindPlaying
 	}
 
-	if query.Text == resource.RatingButtonText {
+	if query.Text == r.lang.RatingButtonText {
 		if player, ok := r.findPlayer(userID); ok {
-			msg := tgbotapi.NewMessage(player.ChatID, r.renderScores())
-			msg.ParseMode = tgbotapi.ModeMarkdown
-			if _, err := r.tg.Send(msg); err != nil {
+			msg := transport.Message{ChatID: player.ChatID, Text: r.renderScores(), Markdown: true}
+			if _, err := r.tg.SendText(msg); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 		}
 	}
 
-	if query.Text == resource.GameSettingButtonText {
+	if r.isManage(userID, query.Text) {
+		if err := r.sendManageMsg(query.Chat.ID); err != nil {
+			return fmt.Errorf("send manage msg: %w", err)
+		}
+	}
+
+	if query.Text == r.lang.GameSettingButtonText {
 		if player, ok := r.findPlayer(userID); ok {
-			msg := tgbotapi.NewMessage(player.ChatID, r.renderSetting())
-			msg.ParseMode = tgbotapi.ModeMarkdown
-			if _, err := r.tg.Send(msg); err != nil {
+			msg := transport.Message{ChatID: player.ChatID, Text: r.renderSetting(), Markdown: true}
+			if _, err := r.tg.SendText(msg); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 		}
@@ -333,7 +555,7 @@ func (r *Session) sendingWorker(ctx context.Context, wg *sync.WaitGroup) {
 	for {
 		select {
 		case msg := <-r.sndCh:
-			if _, err := r.tg.Send(msg); err != nil {
+			if _, err := r.tg.SendText(msg); err != nil {
 				logger.Errorf("send tg: %v", err)
 			}
 		case <-ctx.Done():
@@ -351,24 +573,9 @@ func (r *Session) shutdown(ctx context.Context) {
 		close(r.stateCh)
 	}()
 
-	if time.Since(r.CreatedAt) <= r.timeout {
+	if r.activeTime() <= r.timeout {
 		if r.getState() != StateKindFinished {
-			r.mtx.RLock()
-		OuterLoop:
-			for _, player := range r.Players {
-				player := player
-				if !player.IsPlaying() || player.Offline {
-					continue OuterLoop
-				}
-
-				msg := tgbotapi.NewMessage(player.ChatID, resource.TextMatchWarnMsg)
-				msg.ParseMode = tgbotapi.ModeMarkdown
-				if _, err := r.tg.Send(msg); err != nil {
-					continue OuterLoop
-				}
-			}
-
-			r.mtx.RUnlock()
+			r.syncBroadcast(r.lang.TextMatchWarnMsg)
 
 			if err := r.warnFn(r); err != nil {
 				logger.Errorf("done function: %v", err)
@@ -380,6 +587,10 @@ func (r *Session) shutdown(ctx context.Context) {
 		if err := r.doneFn(r); err != nil {
 			logger.Errorf("done function: %v", err)
 		}
+	} else if r.Config.ExpireFn != nil {
+		if err := r.Config.ExpireFn(r); err != nil {
+			logger.Errorf("expire function: %v", err)
+		}
 	}
 
 	logger.Infof("The game session closed, author: %s", r.Config.AuthorName)
@@ -389,6 +600,10 @@ func (r *Session) playing(ctx context.Context) error {
 	logger := logging.FromContext(ctx).Named("match.Session.playing")
 PlayerLoop:
 	for {
+		if err := r.waitResumed(ctx); err != nil {
+			return err
+		}
+
 		// choosing the next player
 		player, ok := r.nextPlayer()
 		if !ok {
@@ -396,20 +611,39 @@ PlayerLoop:
 			return nil
 		}
 		logger.Infof("Next playing %s Game session %d, author: %s", player.User.FirstName, r.Config.Code, r.Config.AuthorName)
+		idx := r.playerIdx(player)
+		r.mtx.Lock()
+		r.CurrPlayerIdx = idx
+		r.mtx.Unlock()
+		r.checkpoint(ctx)
+		r.startTurn()
+
 		rate := &model.Rate{}
 
 		r.currRoundSeconds = r.Config.RoundTime
 		r.bloopsPoints = 0
 
 		// send "next player" asyncBroadcast message
-		nextPlayerMsg := fmt.Sprintf(resource.TextNextPlayerMsg, player.FormatFirstName())
+		nextPlayerMsg := fmt.Sprintf(r.lang.TextNextPlayerMsg, player.FormatFirstName())
 		r.syncBroadcast(nextPlayerMsg)
 
+		// the host plays the turn of the offline player on their behalf
+		if player.Offline {
+			msg := transport.Message{
+				ChatID:   player.ChatID,
+				Text:     fmt.Sprintf(r.lang.TextHostedTurnMsg, player.FormatFirstName()),
+				Markdown: true,
+			}
+			if _, err := r.tg.SendText(msg); err != nil {
+				return fmt.Errorf("send msg: %w", err)
+			}
+		}
+
 		util.Sleep(2 * time.Second)
 		if r.Config.IsBloops() {
 			logger.Infof("Checking bloops, game session %d, author: %s", r.Config.Code, r.Config.AuthorName)
-			msg := tgbotapi.NewMessage(player.ChatID, "Проверяем, выпадет ли блюпс?")
-			if _, err := r.tg.Send(msg); err != nil {
+			msg := transport.Message{ChatID: player.ChatID, Text: r.lang.TextCheckBloopsMsg}
+			if _, err := r.tg.SendText(msg); err != nil {
 				return fmt.Errorf("send msg: %w", err)
 			}
 
@@ -418,7 +652,7 @@ PlayerLoop:
 				return fmt.Errorf("send ready set go for bloopses: %w", err)
 			}
 
-			if r.dice() {
+			if nextBloops, ok := r.drawBloops(); ok {
 				logger.Infof(
 					"The bloops dropped for %s, game session %d, author: %s",
 					player.User.FirstName,
@@ -427,16 +661,15 @@ PlayerLoop:
 				)
 
 				rate.Bloops = true
-				msg := tgbotapi.NewDeleteMessage(player.ChatID, messageID)
-				if _, err := r.tg.Send(msg); err != nil {
+				if err := r.tg.DeleteMessage(player.ChatID, messageID); err != nil {
 					return fmt.Errorf("send msg: %w", err)
 				}
 
-				nextBloops, _ := r.randBloopses()
 				r.bloopsPoints = nextBloops.Points
 				r.currRoundSeconds = r.Config.RoundTime + nextBloops.Seconds
 				bloops := &nextBloops
 				rate.BloopsName = bloops.Name
+				r.logPlayerEvent(gamelogModel.EventKindBloops, player, bloopsEvent(nextBloops))
 
 				if err := r.sendDroppedBloopsesMsg(player, bloops); err != nil {
 					return fmt.Errorf("send bloopsbot: %w", err)
@@ -450,8 +683,8 @@ PlayerLoop:
 					r.Config.AuthorName,
 				)
 
-				timerFatal := time.NewTimer(defaultInactiveFatalTime * time.Second)
-				timerWarn := time.NewTimer(defaultInactiveWarnTime * time.Second)
+				timerFatal := newTimer(defaultInactiveFatalTime * time.Second)
+				timerWarn := newTimer(defaultInactiveWarnTime * time.Second)
 			ChallengeNext:
 				for {
 					select {
@@ -462,14 +695,14 @@ PlayerLoop:
 					case <-timerWarn.C:
 						timerWarn.Stop()
 						r.syncBroadcast(fmt.Sprintf(
-							"Игрок %s должен нажать на кнопку Понятно в течение %d сек",
+							r.lang.TextChallengeWarnMsg,
 							player.FormatFirstName(),
 							defaultInactiveFatalTime-defaultInactiveWarnTime,
 						))
 					case <-timerFatal.C:
 						timerFatal.Stop()
 						r.syncBroadcast(fmt.Sprintf(
-							"%s не начал раунд в течение %d сек, он пропускает ход",
+							r.lang.TextSkipTurnMsg,
 							player.FormatFirstName(),
 							defaultInactiveFatalTime,
 						))
@@ -477,15 +710,26 @@ PlayerLoop:
 						continue PlayerLoop
 					case <-ctx.Done():
 						return ErrContextFatalClosed
+					case <-r.pauseSignal():
+						if err := r.waitResumed(ctx, timerWarn, timerFatal); err != nil {
+							return err
+						}
 					case userID := <-r.passCh:
 						if userID == player.UserID {
+							if r.takeSkip() {
+								r.skipped(ctx, player)
+							}
 							continue PlayerLoop
 						}
 					}
 				}
 			} else {
-				msg := tgbotapi.NewEditMessageText(player.ChatID, messageID, emoji.GameDie.String()+" Блюпс не выпал")
-				if _, err := r.tg.Send(msg); err != nil {
+				msg := transport.Message{
+					ChatID:    player.ChatID,
+					MessageID: messageID,
+					Text:      r.lang.TextBloopsNotDroppedMsg,
+				}
+				if err := r.tg.EditText(msg); err != nil {
 					return fmt.Errorf("send msg: %w", err)
 				}
 				util.Sleep(1 * time.Second)
@@ -502,8 +746,8 @@ PlayerLoop:
 			return fmt.Errorf("send start msg: %w", err)
 		}
 
-		timerFatal := time.NewTimer(defaultInactiveFatalTime * time.Second)
-		timerWarn := time.NewTimer(defaultInactiveWarnTime * time.Second)
+		timerFatal := newTimer(defaultInactiveFatalTime * time.Second)
+		timerWarn := newTimer(defaultInactiveWarnTime * time.Second)
 	SessionStart:
 		for {
 			select {
@@ -514,14 +758,14 @@ PlayerLoop:
 			case <-timerWarn.C:
 				timerWarn.Stop()
 				r.syncBroadcast(fmt.Sprintf(
-					"Игрок %s должен нажать на кнопку старта в течение %d сек",
+					r.lang.TextStartWarnMsg,
 					player.FormatFirstName(),
 					defaultInactiveFatalTime-defaultInactiveWarnTime,
 				))
 			case <-timerFatal.C:
 				timerFatal.Stop()
 				r.syncBroadcast(fmt.Sprintf(
-					"%s не начал раунд в течение %d сек, он пропускает ход",
+					r.lang.TextSkipTurnMsg,
 					player.FormatFirstName(),
 					defaultInactiveFatalTime,
 				))
@@ -529,8 +773,15 @@ PlayerLoop:
 				continue PlayerLoop
 			case <-ctx.Done():
 				return ErrContextFatalClosed
+			case <-r.pauseSignal():
+				if err := r.waitResumed(ctx, timerWarn, timerFatal); err != nil {
+					return err
+				}
 			case userID := <-r.passCh:
 				if userID == player.UserID {
+					if r.takeSkip() {
+						r.skipped(ctx, player)
+					}
 					continue PlayerLoop
 				}
 			}
@@ -543,7 +794,8 @@ PlayerLoop:
 			r.Config.AuthorName,
 		)
 		//  generating the letter that the words begin with
-		if err := r.sendLetterMsg(player); err != nil {
+		letter, err := r.sendLetterMsg(player)
+		if err != nil {
 			return fmt.Errorf("generate and send letter msg: %w", err)
 		}
 
@@ -572,12 +824,40 @@ PlayerLoop:
 			player.User.FirstName,
 		)
 
+		if r.Config.TypedAnswers {
+			r.startAnswers(player, letter)
+			if _, err := r.tg.SendText(transport.Message{ChatID: player.ChatID, Text: r.lang.TextTypeAnswersMsg}); err != nil {
+				return fmt.Errorf("send msg: %w", err)
+			}
+		}
+
 		// create ticker. Update player timer every 1sec
 		secs, timeSince, err := r.ticker(ctx, player)
+		skipped := r.takeSkip()
+		if r.Config.TypedAnswers {
+			rate.Answers = r.stopAnswers()
+			if err := r.lookupAnswers(rate.Answers); err != nil {
+				// the unknown answers go to the vote
+				logger.Errorf("lookup answers: %v", err)
+			}
+
+			// the timer may have stopped on its own after all the categories were answered
+			select {
+			case <-r.stopCh:
+			default:
+			}
+		}
+
 		if err != nil {
 			return fmt.Errorf("ticker: %w", err)
 		}
 
+		// the author skipped the turn, the player gets no points for it
+		if skipped {
+			secs = 0
+			rate.Answers = nil
+		}
+
 		logger.Infof(
 			"Game session %d, author: %s, player %s push stop or time over",
 			r.Config.Code,
@@ -585,14 +865,27 @@ PlayerLoop:
 			player.User.FirstName,
 		)
 
+		// the remaining seconds are the points unless the answers are typed
+		points := secs
+		if r.Config.TypedAnswers {
+			points = len(rate.Answers) * resource.AnswerPoints
+		}
+
 		var reward int
-		if secs > 0 {
+		if points > 0 {
 			reward = r.bloopsPoints
 		}
 
 		rate.Duration = time.Since(timeSince)
-		rate.Points = secs + reward
-		rate.Completed = secs > 0
+		rate.Points = points + reward
+		rate.Completed = points > 0
+		r.logPlayerEvent(gamelogModel.EventKindStop, player, gamelogModel.Event{
+			Seconds:  secs,
+			Duration: rate.Duration,
+			Answers:  len(rate.Answers),
+
+			KnownAnswers: knownAnswersNum(rate.Answers),
+		})
 		logger.Infof(
 			"Game session %d, author: %s, player get a %d points",
 			r.Config.Code,
@@ -601,45 +894,47 @@ PlayerLoop:
 		)
 
 		// vote features
-		if r.Config.Vote {
+		if r.Config.Vote && !skipped {
 			logger.Infof(
 				"Game session %d, author: %s, vote starting for player %s",
 				r.Config.Code,
 				r.Config.AuthorName,
 				player.User.FirstName,
 			)
-			if rate.Points <= 0 {
+			switch {
+			case rate.Points <= 0:
 				logger.Infof(
 					"Game session %d, author: %s, points < 0, vote cancelled %s",
 					r.Config.Code,
 					r.Config.AuthorName,
 					player.User.FirstName,
 				)
-				r.syncBroadcast("Игрок не успел справиться с заданием, голосование отменено")
-			} else {
-				if err := r.votes(ctx, rate); err != nil {
+				r.syncBroadcast(r.lang.TextVoteCancelledMsg)
+			case len(rate.Answers) > 0 && knownAnswersNum(rate.Answers) == len(rate.Answers):
+				r.syncBroadcast(r.lang.TextVoteKnownMsg)
+			default:
+				if err := r.votes(ctx, player, rate); err != nil {
 					return fmt.Errorf("votes: %w", err)
 				}
+
+				r.logPlayerEvent(gamelogModel.EventKindVote, player, gamelogModel.Event{
+					ThumbUp:   r.activeVote.count(model.BallotThumbUp),
+					ThumbDown: r.activeVote.count(model.BallotThumbDown),
+					Answers:   acceptedAnswersNum(rate.Answers),
+				})
 			}
 		}
 
-		if _, err := r.tg.Send(tgbotapi.NewStickerShare(player.ChatID, resource.GenerateSticker(rate.Points > 0))); err != nil {
+		if _, err := r.tg.SendSticker(player.ChatID, resource.GenerateSticker(rate.Points > 0)); err != nil {
 			return fmt.Errorf("send sticker: %w", err)
 		}
 
 		r.mtx.Lock()
 		player.Rates = append(player.Rates, rate)
-
-		//  remove the bloops that played
-		if rate.Points > 0 && rate.BloopsName != "" {
-			for idx, bloops := range r.Config.Bloopses {
-				if bloops.Name == rate.BloopsName {
-					r.Config.Bloopses = append(r.Config.Bloopses[:idx], r.Config.Bloopses[idx+1:]...)
-				}
-			}
-		}
-
+		r.lastTurn = player
+		r.CurrPlayerIdx = -1
 		r.mtx.Unlock()
+		r.checkpoint(ctx)
 
 		logger.Infof(
 			"Game session %d, author: %s, rate append for player %s",
@@ -649,7 +944,10 @@ PlayerLoop:
 		)
 		util.Sleep(2 * time.Second)
 		// send data on the round players
-		r.sndCh <- tgbotapi.NewMessage(player.ChatID, fmt.Sprintf(resource.TextStopPlayerRoundMsg, rate.Points))
+		r.sndCh <- transport.Message{
+			ChatID: player.ChatID,
+			Text:   fmt.Sprintf(r.lang.TextStopPlayerRoundMsg, rate.Points, r.lang.Plural(rate.Points, r.lang.NounPoints)),
+		}
 		logger.Infof(
 			"Game session %d, author: %s, round closed for player %s",
 			r.Config.Code,
@@ -671,8 +969,16 @@ func (r *Session) ticker(ctx context.Context, player *model.Player) (int, time.T
 
 	// register stop button handler
 	r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
-		if query.Data == resource.TextStopBtnData {
-			if _, err := r.tg.AnswerCallbackQuery(tgbotapi.NewCallback(query.ID, resource.TextStopBtnDataAnswer)); err != nil {
+		if !isQueryFrom(query, player) {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextNotYourTurnMsg); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		}
+
+		if query.Data == resource.StopBtnData {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextStopBtnDataAnswer); err != nil {
 				return fmt.Errorf("send answer msg: %w", err)
 			}
 
@@ -698,6 +1004,24 @@ OuterLoop:
 			}
 		case <-r.stopCh:
 			break OuterLoop
+		case <-r.pauseSignal():
+			ticker.Stop()
+			pausedAt := time.Now()
+			if err := r.sendPausedTimerMsg(player, messageID, secs); err != nil {
+				return 0, time.Time{}, fmt.Errorf("update timer msg: %w", err)
+			}
+
+			if err := r.waitResumed(ctx); err != nil {
+				return 0, time.Time{}, err
+			}
+
+			// the pause is not a part of the turn duration
+			since = since.Add(time.Since(pausedAt))
+			if err := r.sendWorkingTimerMsg(player, messageID, secs); err != nil {
+				return 0, time.Time{}, fmt.Errorf("update timer msg: %w", err)
+			}
+
+			ticker.Reset(1 * time.Second)
 		case <-ticker.C:
 			// subtract 1 second each tick
 			secs--
@@ -716,27 +1040,39 @@ OuterLoop:
 	return secs, since, nil
 }
 
-func (r *Session) votes(ctx context.Context, rate *model.Rate) error {
+func (r *Session) votes(ctx context.Context, player *model.Player, rate *model.Rate) error {
 	// create new active vote
-	r.activeVote = newVote()
+	r.mtx.Lock()
+	r.activeVote = newVote(player)
+	r.activeVote.answers = rate.Answers
+	votersNum := r.votersNum(player, true)
+	r.mtx.Unlock()
+
+	// nobody can vote on the round of the only player
+	if votersNum == 0 {
+		return nil
+	}
 
-	// for storing the message id
-	voteMessages := map[int64]int{}
+	// for storing the chat id by the message id
+	voteMessages := map[int]int64{}
 
 	// send vote buttons and register callbacks
-	if err := r.sendVotesMsg(voteMessages); err != nil {
+	if err := r.sendVotesMsg(voteMessages, rate); err != nil {
 		return fmt.Errorf("broadcast vote buttons and register msgCallback: %w", err)
 	}
 
-	timer := time.NewTimer(defaultInactiveVoteTime * time.Second)
+	timer := newTimer(time.Duration(r.Config.VoteTimeout) * time.Second)
 	defer timer.Stop()
-	defer close(r.activeVote.pub)
 
 VoteLoop:
 	for {
 		select {
 		case <-ctx.Done():
 			return ErrContextFatalClosed
+		case <-r.pauseSignal():
+			if err := r.waitResumed(ctx, timer); err != nil {
+				return err
+			}
 		case <-timer.C:
 			break VoteLoop
 		case <-r.activeVote.pub:
@@ -754,12 +1090,21 @@ VoteLoop:
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 	// deleting all vote callbacks
-	for _, messageID := range voteMessages {
+	for messageID := range voteMessages {
 		delete(r.msgCallback, messageID)
 	}
 
-	if r.activeVote.thumbUp < r.activeVote.thumbDown {
-		rate.Points = 0
+	r.activeVote.done = true
+	rate.Votes = r.activeVote.trail
+	// the typed answers are voted on word by word, the rest of the points by the ballots on the round
+	answered := len(rate.Answers) * resource.AnswerPoints
+	rate.Points = r.activeVote.resolveAnswers(r.Config.VoteRule)*resource.AnswerPoints + resolveVote(
+		r.Config.VoteRule,
+		rate.Points-answered,
+		r.activeVote.count(model.BallotThumbUp),
+		r.activeVote.count(model.BallotThumbDown),
+	)
+	if rate.Points == 0 {
 		rate.Completed = false
 	}
 
@@ -771,8 +1116,12 @@ func (r *Session) Scores() []PlayerScore {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
 
-	scores := make([]PlayerScore, len(r.Players))
-	for i, player := range r.Players {
+	scores := make([]PlayerScore, 0, len(r.Players))
+	for _, player := range r.Players {
+		if player.Spectator {
+			continue
+		}
+
 		playerScore := PlayerScore{
 			Player: *player,
 			Rounds: len(player.Rates),
@@ -791,7 +1140,7 @@ func (r *Session) Scores() []PlayerScore {
 			playerScore.TotalDuration += rate.Duration
 		}
 
-		scores[i] = playerScore
+		scores = append(scores, playerScore)
 	}
 
 	sort.Slice(scores, func(i, j int) bool {
@@ -808,7 +1157,7 @@ func (r *Session) nextPlayer() (*model.Player, bool) {
 	defer r.mtx.RUnlock()
 
 	for _, player := range r.Players {
-		if player.IsPlaying() && len(player.Rates) <= r.CurrRoundIdx {
+		if player.IsPlaying() && !player.Spectator && len(player.Rates) <= r.CurrRoundIdx {
 			players = append(players, player)
 		}
 	}
@@ -817,77 +1166,175 @@ func (r *Session) nextPlayer() (*model.Player, bool) {
 		return nil, false
 	}
 
-	rnd := fastrand.Uint32n(uint32(len(players)))
-	return players[rnd], true
+	// resume the turn interrupted by a restart
+	if r.CurrPlayerIdx >= 0 && r.CurrPlayerIdx < len(r.Players) {
+		for _, player := range players {
+			if player == r.Players[r.CurrPlayerIdx] {
+				return player, true
+			}
+		}
+	}
+
+	if r.Config.IsTeams() {
+		players = r.teamTurn(players)
+	}
+
+	return players[r.rnd.Intn(len(players))], true
 }
 
+// didEveryoneVote reports whether all the players have voted, the vote does not wait for the spectators
+// unless only they can vote
 func (r *Session) didEveryoneVote() bool {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
-	var playersNum int
-	for _, player := range r.Players {
-		if player.IsPlaying() && !player.Offline {
-			playersNum++
+
+	v := r.activeVote
+	playersNum := r.votersNum(v.player, false)
+	if playersNum == 0 {
+		return len(v.ballots) >= r.votersNum(v.player, true)
+	}
+
+	var n int
+	for voter := range v.ballots {
+		if !voter.Spectator {
+			n++
 		}
 	}
 
-	return r.activeVote.thumbUp+r.activeVote.thumbDown == playersNum
+	return n >= playersNum
 }
 
-func (r *Session) findPlayer(userID int64) (*model.Player, bool) {
-	r.mtx.RLock()
-	defer r.mtx.RUnlock()
-	for _, player := range r.Players {
-		if player.UserID == userID {
-			return player, true
+// canVote reports whether the voter can vote on the round of the player, the player can't vote on their own round,
+// but the host of an offline player can. The offline players vote only if the game allows it, must be called
+// under the lock
+func (r *Session) canVote(voter, player *model.Player) bool {
+	return voter != player &&
+		voter.IsPlaying() &&
+		(!voter.Offline || r.Config.OfflineVote) &&
+		(!voter.Spectator || r.Config.SpectatorsVote)
+}
+
+// votersNum returns the number of users who can vote on the round of the player, the spectators are counted
+// only if requested, must be called under the lock
+func (r *Session) votersNum(player *model.Player, spectators bool) int {
+	var n int
+	for _, p := range r.Players {
+		if (spectators || !p.Spectator) && r.canVote(p, player) {
+			n++
+		}
+	}
+
+	return n
+}
+
+// player finds the player by the user id, must be called under the lock
+func (r *Session) player(userID int64) (*model.Player, bool) {
+	for _, p := range r.Players {
+		if p.UserID == userID {
+			return p, true
 		}
 	}
 
 	return nil, false
 }
 
+// in a group chat everyone sees the buttons of the active player, only the player can press them
+func isQueryFrom(query *tgbotapi.CallbackQuery, player *model.Player) bool {
+	return query.From == nil || int64(query.From.ID) == player.UserID
+}
+
+func (r *Session) findPlayer(userID int64) (*model.Player, bool) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	return r.player(userID)
+}
+
 // register new player and send asyncBroadcast message about it
 func (r *Session) AddPlayer(player *model.Player) error {
-	if player, ok := r.addPlayer(player); ok {
-		registerPlayerMsg := fmt.Sprintf(resource.TextPlayerJoinedGameMsg, player.FormatFirstName())
+	player, ok, err := r.addPlayer(player)
+	if err != nil {
+		return err
+	}
+
+	if ok && player != nil {
+		r.logPlayerEvent(gamelogModel.EventKindPlayerJoined, player, gamelogModel.Event{
+			FirstName: player.User.FirstName,
+			ChatID:    player.ChatID,
+			Offline:   player.Offline,
+			Spectator: player.Spectator,
+			Team:      player.Team,
+		})
+		text := r.lang.TextPlayerJoinedGameMsg
+		if player.Spectator {
+			text = r.lang.TextSpectatorJoinedMsg
+		}
+
+		registerPlayerMsg := fmt.Sprintf(text, player.FormatFirstName())
 		r.asyncBroadcast(registerPlayerMsg, player.UserID)
+
+		// the offline players share the chat with the host, so the host can't pick their team
+		if player.Team > 0 && !player.Offline {
+			if err := r.sendTeamMsg(player); err != nil {
+				return fmt.Errorf("send team msg: %w", err)
+			}
+		}
 	}
 
 	return nil
 }
 
 // create and append new player with State "Playing"
-func (r *Session) addPlayer(player *model.Player) (*model.Player, bool) {
+func (r *Session) addPlayer(player *model.Player) (*model.Player, bool, error) {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
 
+	player.ShowRating = r.Config.ShowRating
+	var n int
 	for _, p := range r.Players {
 		if p.ChatID == player.ChatID && p.UserID == player.UserID && p.FormatFirstName() == player.FormatFirstName() {
 			if player.State == model.PlayerStateKindLeaving {
 				player.State = model.PlayerStateKindPlaying
-				return nil, true
+				return nil, true, nil
 			}
 
-			return nil, false
+			return nil, false, nil
+		}
+
+		if p.IsPlaying() && !p.Spectator {
+			n++
 		}
 	}
 
+	if r.Config.MaxPlayers > 0 && !player.Spectator && n >= r.Config.MaxPlayers {
+		return nil, false, ErrGameFull
+	}
+
+	if r.Config.IsTeams() && !player.Spectator && player.Team == 0 {
+		player.Team = r.smallestTeam()
+	}
+
 	r.Players = append(r.Players, player)
 
-	return player, true
+	return player, true, nil
 }
 
 // remove player from game and send asyncBroadcast message about it
 func (r *Session) RemovePlayer(userID int64) {
 	player, ok := r.findPlayer(userID)
 	if ok {
-		r.asyncBroadcast(fmt.Sprintf(resource.TextPlayerLeftGameMsg, player.FormatFirstName()))
+		r.asyncBroadcast(fmt.Sprintf(r.lang.TextPlayerLeftGameMsg, player.FormatFirstName()))
 		r.removePlayer(userID)
+		r.logPlayerEvent(gamelogModel.EventKindPlayerLeft, player, gamelogModel.Event{})
 		if r.AlivePlayersLen() == 0 && r.getState() == StateKindFinished {
 			r.Stop()
 			return
 		}
-		r.passCh <- player.UserID
+
+		// the spectators have no turn to pass
+		if !player.Spectator {
+			r.passCh <- player.UserID
+		}
 	}
 }
 
@@ -902,66 +1349,133 @@ func (r *Session) removePlayer(userID int64) {
 	}
 }
 
-// change vote condition and publish changes
-
-func (r *Session) thumbUp() {
+// castBallot records the ballot of the user and publishes the changes, the last ballot of the user counts
+func (r *Session) castBallot(userID int64, ballot model.Ballot) bool {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
-	r.activeVote.thumbUp++
-	r.activeVote.pub <- struct{}{}
+
+	for _, p := range r.Players {
+		if p.UserID == userID && !p.Offline {
+			return r.castPlayerBallot(p, ballot)
+		}
+	}
+
+	return false
 }
 
-func (r *Session) thumbDown() {
+// castPlayerBallot records the ballot of the voter, the host casts the ballots of the offline players,
+// must be called under the lock
+func (r *Session) castPlayerBallot(voter *model.Player, ballot model.Ballot) bool {
+	v := r.activeVote
+	if v == nil || v.done || !r.canVote(voter, v.player) {
+		return false
+	}
+
+	if prev, ok := v.ballots[voter]; ok && prev == ballot {
+		return true
+	}
+
+	v.ballots[voter] = ballot
+	vote := model.Vote{UserID: voter.UserID, Ballot: ballot, At: time.Now()}
+	if voter.Offline {
+		vote.Name = voter.User.FirstName
+	}
+
+	v.trail = append(v.trail, vote)
+	select {
+	case v.pub <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+// rejectAnswer toggles the rejection of the typed answer by the user, see rejectPlayerAnswer
+func (r *Session) rejectAnswer(userID int64, idx int) (rejected, ok bool) {
 	r.mtx.Lock()
 	defer r.mtx.Unlock()
-	r.activeVote.thumbDown++
-	r.activeVote.pub <- struct{}{}
-}
 
-func (r *Session) dice() bool {
-	return (int)(fastrand.Uint32n(10)+1) < 7
+	for _, p := range r.Players {
+		if p.UserID == userID && !p.Offline {
+			return r.rejectPlayerAnswer(p, idx)
+		}
+	}
+
+	return false, false
 }
 
-func (r *Session) randBloopses() (resource.Bloops, bool) {
-	if len(r.Config.Bloopses) == 0 {
-		return resource.Bloops{}, false
+// rejectPlayerAnswer toggles the rejection of the typed answer by the voter, the known answers can't be rejected.
+// Returns whether the answer is rejected by the voter now, must be called under the lock
+func (r *Session) rejectPlayerAnswer(voter *model.Player, idx int) (rejected, ok bool) {
+	v := r.activeVote
+	if v == nil || v.done || !r.canVote(voter, v.player) || idx < 0 || idx >= len(v.answers) || v.answers[idx].Known {
+		return false, false
 	}
 
-	rand.Seed(time.Now().UnixNano())
-	for i := 0; i < 3; i++ {
-		rand.Shuffle(len(r.Config.Bloopses), func(i, j int) {
-			r.Config.Bloopses[i], r.Config.Bloopses[j] = r.Config.Bloopses[j], r.Config.Bloopses[i]
-		})
+	if v.rejects[voter] == nil {
+		v.rejects[voter] = map[int]bool{}
 	}
 
-	return r.Config.Bloopses[0], true
+	rejected = !v.rejects[voter][idx]
+	v.rejects[voter][idx] = rejected
+	vote := model.Vote{UserID: voter.UserID, Ballot: model.BallotThumbUp, At: time.Now(), Word: v.answers[idx].Word}
+	if rejected {
+		vote.Ballot = model.BallotThumbDown
+	}
+
+	if voter.Offline {
+		vote.Name = voter.User.FirstName
+	}
+
+	v.trail = append(v.trail, vote)
+	select {
+	case v.pub <- struct{}{}:
+	default:
+	}
+
+	return rejected, true
 }
 
-// nolint
-func (r *Session) randWeightedBloopses() resource.Bloops {
-	var max float64 = -1
-	var result resource.Bloops
-	var mn, mx uint32
+// drawBloops rolls the drop chance and samples the bloops weighted by Bloops.Weight, bloopses that have
+// already dropped in the game are skipped unless they are allowed to repeat
+func (r *Session) drawBloops() (resource.Bloops, bool) {
+	if !roll(r.rnd, r.Config.BloopsChance) {
+		return resource.Bloops{}, false
+	}
 
-	for mn == mx {
-		p1, p2 := fastrand.Uint32n(uint32(len(r.Config.Bloopses))), fastrand.Uint32n(uint32(len(r.Config.Bloopses)))
-		if p1 > p2 {
-			mx, mn = p1, p2
-		} else {
-			mn, mx = p1, p2
+	var dropped map[string]struct{}
+	if !r.Config.BloopsRepeat {
+		dropped = map[string]struct{}{}
+		r.mtx.RLock()
+		for _, player := range r.Players {
+			for _, rate := range player.Rates {
+				if rate.Bloops {
+					dropped[rate.BloopsName] = struct{}{}
+				}
+			}
 		}
+		r.mtx.RUnlock()
 	}
 
-	for _, challenge := range r.Config.Bloopses[mn:mx] {
-		rndNum := float64(fastrand.Uint32n(bloopsMaxWeight)) / bloopsMaxWeight
-		rnd := math.Pow(rndNum, 1/float64(challenge.Weight))
-		if rnd > max {
-			max = rnd
-			result = challenge
-		}
+	return sampleWeighted(r.rnd, r.Config.Bloopses, dropped)
+}
+
+// checkpoint stores the state of the game, so that it can be resumed after a crash
+func (r *Session) checkpoint(ctx context.Context) {
+	if r.Config.CheckpointFn == nil {
+		return
+	}
+
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	if err := r.Config.CheckpointFn(r); err != nil {
+		logging.FromContext(ctx).Named("match.checkpoint").Errorf("checkpoint: %v", err)
 	}
+}
 
-	return result
+// NotifyResumed tells the players that the game restored after a restart continues
+func (r *Session) NotifyResumed() {
+	r.asyncBroadcast(r.lang.TextGameResumedMsg)
 }
 
 func (r *Session) getState() uint8 {
@@ -976,12 +1490,16 @@ func (r *Session) nextRound() {
 	r.CurrRoundIdx++
 }
 
-func (r *Session) syncBroadcast(msg string, exclude ...int64) {
-	r.mtx.RLock()
-	defer r.mtx.RUnlock()
+// recipients returns chats of the players who receive broadcast messages, must be called under the lock.
+// A game bound to a group chat is broadcast once to the group, the excluded players see it there as well
+func (r *Session) recipients(exclude ...int64) []int64 {
+	if r.Config.IsGroup() {
+		return []int64{r.Config.ChatID}
+	}
+
+	var chats []int64
 OuterLoop:
 	for _, player := range r.Players {
-		player := player
 		if !player.IsPlaying() || player.Offline {
 			continue OuterLoop
 		}
@@ -992,32 +1510,26 @@ OuterLoop:
 			}
 		}
 
-		msg := tgbotapi.NewMessage(player.ChatID, msg)
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		if _, err := r.tg.Send(msg); err != nil {
-			continue OuterLoop
-		}
+		chats = append(chats, player.ChatID)
 	}
+
+	return chats
 }
 
-func (r *Session) asyncBroadcast(msg string, exclude ...int64) {
+func (r *Session) syncBroadcast(msg string, exclude ...int64) {
 	r.mtx.RLock()
 	defer r.mtx.RUnlock()
-OuterLoop:
-	for _, player := range r.Players {
-		player := player
-		if !player.IsPlaying() || player.Offline {
-			continue OuterLoop
-		}
-
-		for i := range exclude {
-			if player.UserID == exclude[i] {
-				continue OuterLoop
-			}
+	for _, chatID := range r.recipients(exclude...) {
+		if _, err := r.tg.SendText(transport.Message{ChatID: chatID, Text: msg, Markdown: true}); err != nil {
+			continue
 		}
+	}
+}
 
-		msg := tgbotapi.NewMessage(player.ChatID, msg)
-		msg.ParseMode = tgbotapi.ModeMarkdown
-		r.sndCh <- msg
+func (r *Session) asyncBroadcast(msg string, exclude ...int64) {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	for _, chatID := range r.recipients(exclude...) {
+		r.sndCh <- transport.Message{ChatID: chatID, Text: msg, Markdown: true}
 	}
 }
@@ -0,0 +1,299 @@
+package match
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+func newTestSession(tg *transport.Recorder, userIDs ...int64) *Session {
+	session := NewSession(Config{
+		AuthorID:  userIDs[0],
+		RoundsNum: 1,
+		RoundTime: 30,
+		Vote:      true,
+		Tg:        tg,
+		Timeout:   time.Minute,
+	})
+
+	for _, id := range userIDs {
+		session.Players = append(session.Players, model.NewPlayer(id, userModel.User{ID: id}, false))
+	}
+
+	return session
+}
+
+func clickVote(t *testing.T, session *Session, tg *transport.Recorder, userID int64, data string) {
+	t.Helper()
+	deadline := time.After(5 * time.Second)
+	for {
+		if records := tg.Find(transport.RecordKindText, userID); len(records) > 0 {
+			messageID := records[len(records)-1].MessageID
+			if _, ok := session.cbHandler(messageID); ok {
+				upd := tgbotapi.Update{CallbackQuery: &tgbotapi.CallbackQuery{
+					ID:      "query",
+					From:    &tgbotapi.User{ID: int(userID)},
+					Message: &tgbotapi.Message{MessageID: messageID},
+					Data:    data,
+				}}
+				if err := session.Execute(userID, upd); err != nil {
+					t.Fatalf("execute: %v", err)
+				}
+
+				return
+			}
+		}
+
+		select {
+		case <-deadline:
+			t.Fatalf("no vote message for the user %d", userID)
+		case <-time.After(10 * time.Millisecond):
+		}
+	}
+}
+
+func TestSessionVotes(t *testing.T) {
+	t.Parallel()
+
+	type ballot struct {
+		userID int64
+		data   string
+	}
+
+	testCases := []struct {
+		name           string
+		ballots        []ballot
+		expectedPoints int
+		expectedVotes  int
+	}{
+		{
+			name:           "approved",
+			ballots:        []ballot{{2, resource.TextThumbUp}, {3, resource.TextThumbUp}},
+			expectedPoints: 10,
+			expectedVotes:  2,
+		},
+		{
+			name:           "rejected",
+			ballots:        []ballot{{2, resource.TextThumbDown}, {3, resource.TextThumbDown}},
+			expectedPoints: 0,
+			expectedVotes:  2,
+		},
+		{
+			name:           "changed_vote",
+			ballots:        []ballot{{2, resource.TextThumbDown}, {2, resource.TextThumbUp}, {3, resource.TextThumbUp}},
+			expectedPoints: 10,
+			expectedVotes:  3,
+		},
+		{
+			name:           "repeated_clicks_counted_once",
+			ballots:        []ballot{{2, resource.TextThumbDown}, {2, resource.TextThumbDown}, {3, resource.TextThumbUp}},
+			expectedPoints: 10,
+			expectedVotes:  2,
+		},
+		{
+			name:           "abstain",
+			ballots:        []ballot{{2, resource.TextAbstain}, {3, resource.TextThumbDown}},
+			expectedPoints: 0,
+			expectedVotes:  2,
+		},
+		{
+			name:           "own_round_ignored",
+			ballots:        []ballot{{1, resource.TextThumbDown}, {2, resource.TextThumbUp}, {3, resource.TextThumbUp}},
+			expectedPoints: 10,
+			expectedVotes:  2,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tg := transport.NewRecorder()
+			session := newTestSession(tg, 1, 2, 3)
+			rate := &model.Rate{Points: 10, Completed: true}
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- session.votes(context.Background(), session.Players[0], rate)
+			}()
+
+			for _, b := range tc.ballots {
+				clickVote(t, session, tg, b.userID, b.data)
+			}
+
+			select {
+			case err := <-errCh:
+				if err != nil {
+					t.Fatalf("votes: %v", err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatal("votes did not finish after everyone voted")
+			}
+
+			if rate.Points != tc.expectedPoints {
+				t.Errorf("expected %d points, got %d", tc.expectedPoints, rate.Points)
+			}
+
+			if len(rate.Votes) != tc.expectedVotes {
+				t.Errorf("expected %d recorded votes, got %d", tc.expectedVotes, len(rate.Votes))
+			}
+		})
+	}
+}
+
+func TestSessionSpectators(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		spectatorsVote bool
+	}{
+		{name: "spectators_vote", spectatorsVote: true},
+		{name: "spectators_do_not_vote", spectatorsVote: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			session := newTestSession(transport.NewRecorder(), 1, 2)
+			session.Config.SpectatorsVote = tc.spectatorsVote
+			session.Players = append(session.Players, model.NewSpectator(3, userModel.User{ID: 3}))
+
+			if n := session.AlivePlayersLen(); n != 2 {
+				t.Errorf("expected 2 alive players, got %d", n)
+			}
+
+			if n := len(session.Scores()); n != 2 {
+				t.Errorf("expected 2 scores, got %d", n)
+			}
+
+			for i := 0; i < 10; i++ {
+				if player, ok := session.nextPlayer(); !ok || player.Spectator {
+					t.Fatalf("expected a player, got %+v", player)
+				}
+			}
+
+			session.activeVote = newVote(session.Players[0])
+			if ok := session.castBallot(3, model.BallotThumbDown); ok != tc.spectatorsVote {
+				t.Errorf("expected the spectator ballot accepted %t, got %t", tc.spectatorsVote, ok)
+			}
+
+			if session.didEveryoneVote() {
+				t.Error("the vote must wait for the player")
+			}
+
+			session.castBallot(2, model.BallotThumbUp)
+			if !session.didEveryoneVote() {
+				t.Error("the vote must not wait for the spectator")
+			}
+		})
+	}
+}
+
+func TestSessionOfflineVote(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		offlineVote bool
+		voters      int
+	}{
+		{name: "offline_players_vote", offlineVote: true, voters: 2},
+		{name: "offline_players_do_not_vote", offlineVote: false, voters: 1},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			session := newTestSession(transport.NewRecorder(), 1, 2)
+			session.Config.OfflineVote = tc.offlineVote
+			// the host 1 brings the offline player
+			guest := model.NewPlayer(1, userModel.User{ID: 1, FirstName: "Anna"}, true)
+			session.Players = append(session.Players, guest)
+
+			if n := session.AlivePlayersLen(); n != 3 {
+				t.Errorf("expected 3 alive players, got %d", n)
+			}
+
+			player := session.Players[1]
+			if n := session.votersNum(player, true); n != tc.voters {
+				t.Fatalf("expected %d voters, got %d", tc.voters, n)
+			}
+
+			// the host votes on the turn of the own offline player, the offline player doesn't
+			if !session.canVote(session.Players[0], guest) || session.canVote(guest, guest) {
+				t.Errorf("expected only the host to vote on the turn of the offline player")
+			}
+
+			session.activeVote = newVote(player)
+			session.castBallot(1, model.BallotThumbUp)
+			if session.didEveryoneVote() != !tc.offlineVote {
+				t.Errorf("expected the vote to wait for the offline player %t", tc.offlineVote)
+			}
+
+			session.mtx.Lock()
+			ok := session.castPlayerBallot(guest, model.BallotThumbDown)
+			session.mtx.Unlock()
+			if ok != tc.offlineVote {
+				t.Errorf("expected the offline player ballot accepted %t, got %t", tc.offlineVote, ok)
+			}
+
+			if !session.didEveryoneVote() {
+				t.Error("expected everyone to have voted")
+			}
+
+			if tc.offlineVote && session.activeVote.trail[1].Name != "Anna" {
+				t.Errorf("expected the ballot of the offline player, got %+v", session.activeVote.trail[1])
+			}
+		})
+	}
+}
+
+func TestSessionGroupBroadcast(t *testing.T) {
+	t.Parallel()
+
+	tg := transport.NewRecorder()
+	session := newTestSession(tg, 1, 2)
+	session.Config.ChatID = -100
+
+	session.syncBroadcast("hello")
+	session.syncBroadcast("excluded", 1)
+
+	records := tg.Records()
+	if len(records) != 2 {
+		t.Fatalf("expected a message per broadcast, got %d", len(records))
+	}
+
+	for i, text := range []string{"hello", "excluded"} {
+		if records[i].ChatID != -100 || records[i].Text != text {
+			t.Errorf("expected %q to the group chat, got %+v", text, records[i])
+		}
+	}
+}
+
+func TestSessionResumeTurn(t *testing.T) {
+	t.Parallel()
+
+	session := newTestSession(transport.NewRecorder(), 1, 2, 3)
+	session.CurrPlayerIdx = 2
+
+	for i := 0; i < 10; i++ {
+		player, ok := session.nextPlayer()
+		if !ok || player.UserID != 3 {
+			t.Fatalf("nextPlayer: expected the interrupted turn of player 3 to resume")
+		}
+	}
+
+	session.Players[2].Rates = append(session.Players[2].Rates, &model.Rate{})
+	if player, ok := session.nextPlayer(); !ok || player.UserID == 3 {
+		t.Errorf("nextPlayer: expected a player who hasn't played in this round")
+	}
+}
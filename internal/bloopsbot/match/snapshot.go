@@ -0,0 +1,67 @@
+package match
+
+import (
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+)
+
+// Snapshot returns a copy of the state of the game to resume it after a restart,
+// the copy is taken under the lock and does not share the players with the running game
+func (r *Session) Snapshot() model.State {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+
+	s := model.State{
+		Timeout:       r.Config.Timeout,
+		AuthorID:      r.Config.AuthorID,
+		AuthorName:    r.Config.AuthorName,
+		RoundsNum:     r.Config.RoundsNum,
+		RoundTime:     r.Config.RoundTime,
+		Vote:          r.Config.Vote,
+		Code:          r.Config.Code,
+		ChatID:        r.Config.ChatID,
+		Locale:        r.Config.Locale,
+		BloopsChance:  r.Config.BloopsChance,
+		BloopsRepeat:  r.Config.BloopsRepeat,
+		Seed:          r.Config.Seed,
+		Draws:         r.rnd.draws(),
+		VoteRule:      r.Config.VoteRule,
+		VoteTimeout:   r.Config.VoteTimeout,
+		TypedAnswers:  r.Config.TypedAnswers,
+		State:         r.RunningState(),
+		CurrRoundIdx:  r.CurrRoundIdx,
+		CurrPlayerIdx: r.CurrPlayerIdx,
+		CreatedAt:     r.CreatedAt,
+		Categories:    make([]string, len(r.Config.Categories)),
+		Letters:       make([]string, len(r.Config.Letters)),
+		Bloopses:      make([]resource.Bloops, len(r.Config.Bloopses)),
+		Players:       make([]*model.Player, len(r.Players)),
+
+		SpectatorsVote: r.Config.SpectatorsVote,
+		Teams:          append([]string(nil), r.Config.Teams...),
+		OfflineVote:    r.Config.OfflineVote,
+		Private:        r.Config.Private,
+		PIN:            r.Config.PIN,
+		Public:         r.Config.Public,
+		MaxPlayers:     r.Config.MaxPlayers,
+
+		PausedFor: r.PausedFor,
+	}
+
+	copy(s.Categories, r.Config.Categories)
+	copy(s.Letters, r.Config.Letters)
+	copy(s.Bloopses, r.Config.Bloopses)
+
+	for i, player := range r.Players {
+		p := *player
+		p.Rates = make([]*model.Rate, len(player.Rates))
+		for j, rate := range player.Rates {
+			rt := *rate
+			p.Rates[j] = &rt
+		}
+
+		s.Players[i] = &p
+	}
+
+	return s
+}
@@ -0,0 +1,38 @@
+package match
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+)
+
+func TestSnapshotResume(t *testing.T) {
+	t.Parallel()
+
+	tg := transport.NewRecorder()
+	session := newTestSession(tg, 1, 2)
+	session.PausedFor = time.Minute
+	for i := 0; i < 5; i++ {
+		session.rnd.Intn(100)
+		_ = session.rnd.Float64()
+	}
+
+	s := session.Snapshot()
+	if s.Draws == 0 || s.PausedFor != time.Minute || !s.CreatedAt.Equal(session.CreatedAt) {
+		t.Fatalf("expected the draws, the pauses and the creation time in the snapshot, got %+v", s)
+	}
+
+	// the snapshot does not share the players with the running game
+	s.Players[0].User.FirstName = "changed"
+	if session.Players[0].User.FirstName == "changed" {
+		t.Error("expected the players to be copied")
+	}
+
+	resumed := NewSession(Config{Seed: s.Seed, Draws: s.Draws, Tg: tg})
+	for i := 0; i < 10; i++ {
+		if expected, got := session.rnd.Intn(1000), resumed.rnd.Intn(1000); expected != got {
+			t.Fatalf("draw %d: expected the resumed game to continue the sequence with %d, got %d", i, expected, got)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package match
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	gamelogModel "github.com/bloops-games/bloops/internal/database/gamelog/model"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	"github.com/enescakir/emoji"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+// TeamScore is the total of the team and the scores of its members
+type TeamScore struct {
+	Team    int
+	Name    string
+	Points  int
+	Members []PlayerScore
+}
+
+// TeamScores calculates the team rating, the members are sorted by the points
+func (r *Session) TeamScores() []TeamScore {
+	scores := make([]TeamScore, len(r.Config.Teams))
+	for i, name := range r.Config.Teams {
+		scores[i] = TeamScore{Team: i + 1, Name: name}
+	}
+
+	for _, score := range r.Scores() {
+		team := score.Player.Team
+		if team < 1 || team > len(scores) {
+			continue
+		}
+
+		scores[team-1].Points += score.Points
+		scores[team-1].Members = append(scores[team-1].Members, score)
+	}
+
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].Points > scores[j].Points
+	})
+
+	return scores
+}
+
+// winningTeams returns the teams with the most points
+func (r *Session) winningTeams() []TeamScore {
+	scores := r.TeamScores()
+	for i := range scores {
+		if scores[i].Points < scores[0].Points {
+			return scores[:i]
+		}
+	}
+
+	return scores
+}
+
+// smallestTeam returns the team with the fewest players, must be called under the lock
+func (r *Session) smallestTeam() int {
+	sizes := make([]int, len(r.Config.Teams))
+	for _, p := range r.Players {
+		if p.Team > 0 && p.Team <= len(sizes) && p.IsPlaying() {
+			sizes[p.Team-1]++
+		}
+	}
+
+	team := 1
+	for i, size := range sizes {
+		if size < sizes[team-1] {
+			team = i + 1
+		}
+	}
+
+	return team
+}
+
+// teamTurn leaves the candidates of the team whose turn it is, the team that has played the fewest turns
+// in the round goes next, so the turns alternate between the teams. Must be called under the lock
+func (r *Session) teamTurn(candidates []*model.Player) []*model.Player {
+	played := make([]int, len(r.Config.Teams)+1)
+	for _, p := range r.Players {
+		if p.Team < len(played) && len(p.Rates) > r.CurrRoundIdx {
+			played[p.Team]++
+		}
+	}
+
+	team := -1
+	for _, p := range candidates {
+		if p.Team < len(played) && (team == -1 || played[p.Team] < played[team] ||
+			played[p.Team] == played[team] && p.Team < team) {
+			team = p.Team
+		}
+	}
+
+	var players []*model.Player
+	for _, p := range candidates {
+		if p.Team == team {
+			players = append(players, p)
+		}
+	}
+
+	return players
+}
+
+// sendTeamMsg tells the player the team and lets the player switch it before the game starts
+func (r *Session) sendTeamMsg(player *model.Player) error {
+	r.mtx.RLock()
+	markup := r.renderTeamButtons(player.Team)
+	text := fmt.Sprintf(r.lang.TextTeamMsg, r.Config.Teams[player.Team-1])
+	r.mtx.RUnlock()
+
+	messageID, err := r.tg.SendText(transport.Message{ChatID: player.ChatID, Text: text, Markdown: true, Keyboard: markup})
+	if err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	r.registerCbHandler(messageID, func(query *tgbotapi.CallbackQuery) error {
+		if !isQueryFrom(query, player) {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextNotYourTurnMsg); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		}
+
+		team, err := strconv.Atoi(query.Data)
+		if err != nil {
+			return fmt.Errorf("strconv: %w", err)
+		}
+
+		if !r.changeTeam(player, team) {
+			if err := r.tg.AnswerCallback(query.ID, r.lang.TextTeamNotChangeable); err != nil {
+				return fmt.Errorf("send answer msg: %w", err)
+			}
+
+			return nil
+		}
+
+		r.logPlayerEvent(gamelogModel.EventKindTeam, player, gamelogModel.Event{Team: team})
+		if err := r.tg.AnswerCallback(
+			query.ID,
+			fmt.Sprintf(r.lang.TextTeamChosen, r.Config.Teams[team-1]),
+		); err != nil {
+			return fmt.Errorf("send answer msg: %w", err)
+		}
+
+		r.mtx.RLock()
+		markup := r.renderTeamButtons(team)
+		r.mtx.RUnlock()
+		if err := r.tg.EditKeyboard(player.ChatID, messageID, markup); err != nil {
+			return fmt.Errorf("edit keyboard: %w", err)
+		}
+
+		return nil
+	})
+
+	return nil
+}
+
+// changeTeam moves the player to the team while the game is waiting for the start
+func (r *Session) changeTeam(player *model.Player, team int) bool {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	if r.State != StateKindWaiting || team < 1 || team > len(r.Config.Teams) {
+		return false
+	}
+
+	player.Team = team
+
+	return true
+}
+
+func (r *Session) renderTeamButtons(team int) tgbotapi.InlineKeyboardMarkup {
+	row := tgbotapi.NewInlineKeyboardRow()
+	for i, name := range r.Config.Teams {
+		text := name
+		if i+1 == team {
+			text = emoji.CheckMarkButton.String() + " " + name
+		}
+
+		row = append(row, tgbotapi.NewInlineKeyboardButtonData(text, strconv.Itoa(i+1)))
+	}
+
+	return tgbotapi.NewInlineKeyboardMarkup(row)
+}
@@ -0,0 +1,62 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	"github.com/bloops-games/bloops/internal/database/matchstate/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+)
+
+func TestSessionTeamTurns(t *testing.T) {
+	t.Parallel()
+
+	session := newTestSession(transport.NewRecorder(), 1)
+	session.Players = nil
+	session.Config.Teams = []string{"Red", "Blue"}
+	for _, id := range []int64{1, 2, 3} {
+		session.addPlayer(model.NewPlayer(id, userModel.User{ID: id}, false))
+	}
+
+	// the players are spread over the teams
+	teams := map[int]int{}
+	for _, player := range session.Players {
+		teams[player.Team]++
+	}
+
+	if teams[1] != 2 || teams[2] != 1 {
+		t.Fatalf("expected 2 and 1 players in the teams, got %v", teams)
+	}
+
+	var turns []int
+	for {
+		player, ok := session.nextPlayer()
+		if !ok {
+			break
+		}
+
+		turns = append(turns, player.Team)
+		player.Rates = append(player.Rates, &model.Rate{Points: 10 * player.Team})
+	}
+
+	expected := []int{1, 2, 1}
+	if len(turns) != len(expected) {
+		t.Fatalf("expected turns %v, got %v", expected, turns)
+	}
+
+	for i := range expected {
+		if turns[i] != expected[i] {
+			t.Fatalf("expected turns %v, got %v", expected, turns)
+		}
+	}
+
+	// Red has 10 + 10 points, Blue has 20 points
+	scores := session.TeamScores()
+	if scores[0].Points != 20 || scores[1].Points != 20 {
+		t.Fatalf("expected 20 points for both teams, got %+v", scores)
+	}
+
+	if n := len(session.Favorites()); n != 3 {
+		t.Errorf("expected the members of both teams to be favorites, got %d", n)
+	}
+}
@@ -0,0 +1,41 @@
+package match
+
+import "github.com/bloops-games/bloops/internal/bloopsbot/resource"
+
+// resolveVote returns the points of the round after the vote by the rule of the game,
+// abstained ballots are not counted and the points are kept if nobody has voted
+func resolveVote(rule resource.VoteRule, points, thumbUp, thumbDown int) int {
+	cast := thumbUp + thumbDown
+	if cast == 0 {
+		return points
+	}
+
+	switch rule {
+	case resource.VoteRuleTwoThirds:
+		if 3*thumbDown >= 2*cast {
+			return 0
+		}
+	case resource.VoteRuleUnanimous:
+		if thumbUp == 0 {
+			return 0
+		}
+	case resource.VoteRulePartial:
+		return points * thumbUp / cast
+	default:
+		if thumbUp < thumbDown {
+			return 0
+		}
+	}
+
+	return points
+}
+
+// answerAccepted resolves the vote on the typed answer by the rule of the game, the answer is accepted
+// or rejected as a whole, so the partial rule accepts it by the majority
+func answerAccepted(rule resource.VoteRule, thumbUp, thumbDown int) bool {
+	if rule == resource.VoteRulePartial {
+		rule = resource.VoteRuleMajority
+	}
+
+	return resolveVote(rule, resource.AnswerPoints, thumbUp, thumbDown) > 0
+}
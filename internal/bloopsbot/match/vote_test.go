@@ -0,0 +1,41 @@
+package match
+
+import (
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+)
+
+func TestResolveVote(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name      string
+		rule      resource.VoteRule
+		thumbUp   int
+		thumbDown int
+		expected  int
+	}{
+		{name: "majority_approved", rule: resource.VoteRuleMajority, thumbUp: 2, thumbDown: 1, expected: 12},
+		{name: "majority_tie", rule: resource.VoteRuleMajority, thumbUp: 1, thumbDown: 1, expected: 12},
+		{name: "majority_rejected", rule: resource.VoteRuleMajority, thumbUp: 1, thumbDown: 2, expected: 0},
+		{name: "two_thirds_approved", rule: resource.VoteRuleTwoThirds, thumbUp: 2, thumbDown: 3, expected: 12},
+		{name: "two_thirds_rejected", rule: resource.VoteRuleTwoThirds, thumbUp: 1, thumbDown: 2, expected: 0},
+		{name: "unanimous_approved", rule: resource.VoteRuleUnanimous, thumbUp: 1, thumbDown: 4, expected: 12},
+		{name: "unanimous_rejected", rule: resource.VoteRuleUnanimous, thumbUp: 0, thumbDown: 4, expected: 0},
+		{name: "partial", rule: resource.VoteRulePartial, thumbUp: 2, thumbDown: 1, expected: 8},
+		{name: "partial_rejected", rule: resource.VoteRulePartial, thumbUp: 0, thumbDown: 3, expected: 0},
+		{name: "nobody_voted", rule: resource.VoteRuleUnanimous, expected: 12},
+		{name: "unknown_rule_is_majority", thumbUp: 1, thumbDown: 2, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			if points := resolveVote(tc.rule, 12, tc.thumbUp, tc.thumbDown); points != tc.expected {
+				t.Errorf("expected %d points, got %d", tc.expected, points)
+			}
+		})
+	}
+}
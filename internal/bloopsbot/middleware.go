@@ -3,13 +3,17 @@ package bloopsbot
 import (
 	"fmt"
 
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
 	userModel "github.com/bloops-games/bloops/internal/database/user/model"
-	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
 )
 
 func (m *manager) isAdmin(u userModel.User, chatID int64) (bool, error) {
 	if !u.Admin {
-		if _, err := m.tg.Send(tgbotapi.NewMessage(chatID, "Для этой команды нужны права администратора")); err != nil {
+		if _, err := m.tg.SendText(transport.Message{
+			ChatID: chatID,
+			Text:   resource.Lang(u.LanguageCode).TextAdminRequiredMsg,
+		}); err != nil {
 			return false, fmt.Errorf("send msg: %w", err)
 		}
 
@@ -21,7 +25,7 @@ func (m *manager) isAdmin(u userModel.User, chatID int64) (bool, error) {
 
 func (m *manager) isActive(u userModel.User, chatID int64) (bool, error) {
 	if !u.Admin && u.Status == userModel.StatusBanned {
-		if _, err := m.tg.Send(tgbotapi.NewMessage(chatID, "Бан")); err != nil {
+		if _, err := m.tg.SendText(transport.Message{ChatID: chatID, Text: resource.Lang(u.LanguageCode).TextBannedMsg}); err != nil {
 			return false, fmt.Errorf("send msg: %w", err)
 		}
 
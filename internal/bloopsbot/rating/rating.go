@@ -0,0 +1,143 @@
+package rating
+
+import "math"
+
+const (
+	// DefaultValue, DefaultDeviation and DefaultVolatility are the rating of a new player
+	DefaultValue      = 1500.0
+	DefaultDeviation  = 350.0
+	DefaultVolatility = 0.06
+
+	// constrains the change of the volatility over time
+	tau = 0.5
+	// convergence tolerance of the volatility iteration
+	epsilon = 0.000001
+	// converts the rating to the Glicko-2 scale
+	scale = 173.7178
+)
+
+// Rating of the player by the Glicko-2 rating system
+type Rating struct {
+	Value      float64
+	Deviation  float64
+	Volatility float64
+}
+
+// Default returns the rating of a new player
+func Default() Rating {
+	return Rating{Value: DefaultValue, Deviation: DefaultDeviation, Volatility: DefaultVolatility}
+}
+
+// Rate returns the ratings of the players after the game. The game is rated as a match of every player
+// against every other player: the smaller place wins and the equal places draw
+func Rate(ratings []Rating, places []int) []Rating {
+	rated := make([]Rating, len(ratings))
+	for i, r := range ratings {
+		if r.Deviation <= 0 || r.Volatility <= 0 {
+			r = Default()
+		}
+
+		rated[i] = r
+	}
+
+	if len(ratings) < 2 || len(places) != len(ratings) {
+		return rated
+	}
+
+	result := make([]Rating, len(rated))
+	for i, player := range rated {
+		opponents := make([]Rating, 0, len(rated)-1)
+		outcomes := make([]float64, 0, len(rated)-1)
+		for j, opponent := range rated {
+			if i == j {
+				continue
+			}
+
+			outcome := 0.5
+			switch {
+			case places[i] < places[j]:
+				outcome = 1
+			case places[i] > places[j]:
+				outcome = 0
+			}
+
+			opponents = append(opponents, opponent)
+			outcomes = append(outcomes, outcome)
+		}
+
+		result[i] = update(player, opponents, outcomes)
+	}
+
+	return result
+}
+
+// update is the rating period of the Glicko-2 rating system with the games against the opponents
+func update(player Rating, opponents []Rating, outcomes []float64) Rating {
+	mu := (player.Value - DefaultValue) / scale
+	phi := player.Deviation / scale
+
+	var variance, improvement float64
+	for i, opponent := range opponents {
+		muJ := (opponent.Value - DefaultValue) / scale
+		g := gFunc(opponent.Deviation / scale)
+		e := 1 / (1 + math.Exp(-g*(mu-muJ)))
+		variance += g * g * e * (1 - e)
+		improvement += g * (outcomes[i] - e)
+	}
+
+	v := 1 / variance
+	delta := v * improvement
+	sigma := volatility(phi, player.Volatility, v, delta)
+
+	phiStar := math.Sqrt(phi*phi + sigma*sigma)
+	phiNew := 1 / math.Sqrt(1/(phiStar*phiStar)+1/v)
+	muNew := mu + phiNew*phiNew*improvement
+
+	return Rating{
+		Value:      muNew*scale + DefaultValue,
+		Deviation:  math.Min(phiNew*scale, DefaultDeviation),
+		Volatility: sigma,
+	}
+}
+
+func gFunc(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// volatility finds the new volatility by the Illinois algorithm
+func volatility(phi, sigma, v, delta float64) float64 {
+	a := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		d := phi*phi + v + ex
+		return ex*(delta*delta-phi*phi-v-ex)/(2*d*d) - (x-a)/(tau*tau)
+	}
+
+	lower := a
+	var upper float64
+	if delta*delta > phi*phi+v {
+		upper = math.Log(delta*delta - phi*phi - v)
+	} else {
+		k := 1.0
+		for f(a-k*tau) < 0 {
+			k++
+		}
+
+		upper = a - k*tau
+	}
+
+	fLower, fUpper := f(lower), f(upper)
+	for math.Abs(upper-lower) > epsilon {
+		c := lower + (lower-upper)*fLower/(fUpper-fLower)
+		fC := f(c)
+		if fC*fUpper <= 0 {
+			lower, fLower = upper, fUpper
+		} else {
+			fLower /= 2
+		}
+
+		upper, fUpper = c, fC
+	}
+
+	return math.Exp(lower / 2)
+}
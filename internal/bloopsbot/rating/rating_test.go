@@ -0,0 +1,100 @@
+package rating
+
+import (
+	"math"
+	"testing"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	// the example of the Glicko-2 paper
+	player := Rating{Value: 1500, Deviation: 200, Volatility: 0.06}
+	opponents := []Rating{
+		{Value: 1400, Deviation: 30, Volatility: 0.06},
+		{Value: 1550, Deviation: 100, Volatility: 0.06},
+		{Value: 1700, Deviation: 300, Volatility: 0.06},
+	}
+
+	r := update(player, opponents, []float64{1, 0, 0})
+	if math.Abs(r.Value-1464.06) > 0.01 || math.Abs(r.Deviation-151.52) > 0.01 || math.Abs(r.Volatility-0.05999) > 0.00001 {
+		t.Errorf("expected 1464.06 ± 151.52 with volatility 0.05999, got %+v", r)
+	}
+}
+
+func TestRate(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		ratings  []Rating
+		places   []int
+		expected func(t *testing.T, rated []Rating)
+	}{
+		{
+			name:    "new_players",
+			ratings: []Rating{{}, {}, {}},
+			places:  []int{0, 1, 2},
+			expected: func(t *testing.T, rated []Rating) {
+				if !(rated[0].Value > DefaultValue && rated[1].Value == DefaultValue && rated[2].Value < DefaultValue) {
+					t.Errorf("expected the winner to gain and the last to lose, got %+v", rated)
+				}
+
+				for _, r := range rated {
+					if r.Deviation >= DefaultDeviation {
+						t.Errorf("expected the deviation to shrink, got %+v", r)
+					}
+				}
+			},
+		},
+		{
+			name:    "draw",
+			ratings: []Rating{Default(), Default()},
+			places:  []int{0, 0},
+			expected: func(t *testing.T, rated []Rating) {
+				if rated[0].Value != DefaultValue || rated[1].Value != DefaultValue {
+					t.Errorf("expected the draw of the equal players to keep the ratings, got %+v", rated)
+				}
+			},
+		},
+		{
+			name: "upset",
+			ratings: []Rating{
+				{Value: 1400, Deviation: 80, Volatility: 0.06},
+				{Value: 1800, Deviation: 80, Volatility: 0.06},
+			},
+			places: []int{0, 1},
+			expected: func(t *testing.T, rated []Rating) {
+				weak := rated[0].Value - 1400
+				if weak <= 0 || math.Abs(weak-(1800-rated[1].Value)) > 0.01 {
+					t.Errorf("expected the weak winner to take the points of the strong player, got %+v", rated)
+				}
+
+				// beating the stronger player is worth more than beating the equal one
+				weakest := Rating{Value: 1400, Deviation: 80, Volatility: 0.06}
+				even := Rate([]Rating{weakest, weakest}, []int{0, 1})
+				if gain := even[0].Value - 1400; weak <= gain {
+					t.Errorf("expected the upset to gain more than %f, got %f", gain, weak)
+				}
+			},
+		},
+		{
+			name:    "single_player",
+			ratings: []Rating{{Value: 1600, Deviation: 50, Volatility: 0.06}},
+			places:  []int{0},
+			expected: func(t *testing.T, rated []Rating) {
+				if rated[0].Value != 1600 || rated[0].Deviation != 50 {
+					t.Errorf("expected the single player not to be rated, got %+v", rated)
+				}
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			tc.expected(t, Rate(tc.ratings, tc.places))
+		})
+	}
+}
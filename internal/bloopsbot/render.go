@@ -2,45 +2,137 @@ package bloopsbot
 
 import (
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
+	"github.com/bloops-games/bloops/internal/bloopsbot/rating"
 	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	leaderboardModel "github.com/bloops-games/bloops/internal/database/leaderboard/model"
 	statModel "github.com/bloops-games/bloops/internal/database/stat/model"
 	userModel "github.com/bloops-games/bloops/internal/database/user/model"
 	"github.com/bloops-games/bloops/internal/strpool"
 	"github.com/enescakir/emoji"
 )
 
-func renderProfile(u userModel.User, stat statModel.AggregationStat) string {
+func renderProfile(lang *resource.Catalog, u userModel.User, stat statModel.AggregationStat) string {
 	buf := strpool.Get()
 	defer func() {
 		buf.Reset()
 		strpool.Put(buf)
 	}()
-	_, _ = fmt.Fprintf(buf, "%s Профиль игрока *%s*\n\n", emoji.Alien.String(), u.FirstName)
-	_, _ = fmt.Fprintf(buf, "%s Сыграно: %s\n", emoji.VideoGame.String(), strconv.Itoa(stat.Count))
-	_, _ = fmt.Fprintf(buf, "%s Побед: %s\n", emoji.Star.String(), strconv.Itoa(stat.Stars))
+	_, _ = fmt.Fprintf(buf, lang.TextProfileTitle, emoji.Alien.String(), u.FirstName)
+	_, _ = fmt.Fprintf(buf, lang.TextProfileGames, emoji.VideoGame.String(), strconv.Itoa(stat.Count))
+	_, _ = fmt.Fprintf(buf, lang.TextProfileStars, emoji.Star.String(), strconv.Itoa(stat.Stars))
+	if u.Rating.IsRated() {
+		_, _ = fmt.Fprintf(
+			buf,
+			lang.TextProfileRating,
+			emoji.ChartIncreasing.String(),
+			int(math.Round(u.Rating.Value)),
+			int(math.Round(u.Rating.Deviation)),
+			ratingChange(u.Rating),
+		)
+	} else {
+		_, _ = fmt.Fprintf(buf, lang.TextProfileNotRated, emoji.ChartIncreasing.String())
+	}
 	_, _ = fmt.Fprintf(
 		buf,
-		"%s Блюпсов открыто: %s/%s\n",
+		lang.TextProfileBloops,
 		emoji.GemStone.String(),
 		strconv.Itoa(len(stat.Bloops)),
-		strconv.Itoa(len(resource.BloopsKeys)),
+		strconv.Itoa(lang.BloopsNum()),
 	)
 	_, _ = fmt.Fprintf(
 		buf,
-		"%s Лучшее время раунда: %s\n",
+		lang.TextProfileBestTime,
 		emoji.Stopwatch.String(),
 		stat.BestDuration.Round(100*time.Millisecond).String(),
 	)
 	_, _ = fmt.Fprintf(
 		buf,
-		"%s Среднее время раунда: %s\n",
+		lang.TextProfileAverageTime,
 		emoji.Stopwatch.String(),
 		stat.AvgDuration.Round(100*time.Millisecond).String(),
 	)
-	_, _ = fmt.Fprintf(buf, "%s Лучший счет раунда: %s", emoji.HundredPoints.String(), strconv.Itoa(stat.BestPoints))
+	_, _ = fmt.Fprintf(buf, lang.TextProfileBestPoints, emoji.HundredPoints.String(), strconv.Itoa(stat.BestPoints))
 
 	return buf.String()
 }
+
+// ratingChange formats the change of the rating in the latest rated game
+func ratingChange(r userModel.Rating) string {
+	prev := rating.DefaultValue
+	if len(r.History) > 1 {
+		prev = r.History[len(r.History)-2].Value
+	}
+
+	change := int(math.Round(r.Value - prev))
+	if change >= 0 {
+		return "+" + strconv.Itoa(change)
+	}
+
+	return strconv.Itoa(change)
+}
+
+// renderTop renders the leaderboard with the place of the user, own is nil if the user is not on the leaderboard
+func renderTop(
+	lang *resource.Catalog,
+	metric leaderboardModel.Metric,
+	season string,
+	entries []leaderboardModel.Entry,
+	own *leaderboardModel.Entry,
+) string {
+	buf := strpool.Get()
+	defer func() {
+		buf.Reset()
+		strpool.Put(buf)
+	}()
+
+	seasonName := lang.TextTopMonth
+	if season == leaderboardModel.SeasonAllTime {
+		seasonName = lang.TextTopAllTime
+	}
+
+	_, _ = fmt.Fprintf(buf, lang.TextTopTitle, emoji.Trophy.String(), topMetricName(lang, metric), seasonName)
+	if len(entries) == 0 {
+		buf.WriteString(lang.TextTopEmptyMsg)
+	}
+
+	medals := []emoji.Emoji{emoji.FirstPlaceMedal, emoji.SecondPlaceMedal, emoji.ThirdPlaceMedal}
+	for _, entry := range entries {
+		place := strconv.Itoa(entry.Rank) + "."
+		if entry.Rank <= len(medals) {
+			place = medals[entry.Rank-1].String()
+		}
+
+		_, _ = fmt.Fprintf(buf, lang.TextTopEntry, place, entry.Score.FirstName, topValue(metric, entry.Value))
+	}
+
+	if own != nil {
+		_, _ = fmt.Fprintf(buf, lang.TextTopPosition, own.Rank, topValue(metric, own.Value))
+	}
+
+	return buf.String()
+}
+
+func topMetricName(lang *resource.Catalog, metric leaderboardModel.Metric) string {
+	switch metric {
+	case leaderboardModel.MetricPoints:
+		return lang.TextTopPoints
+	case leaderboardModel.MetricTime:
+		return lang.TextTopTime
+	case leaderboardModel.MetricBloops:
+		return lang.TextTopBloops
+	}
+
+	return lang.TextTopStars
+}
+
+func topValue(metric leaderboardModel.Metric, value int64) string {
+	if metric == leaderboardModel.MetricTime {
+		return time.Duration(value).Round(100 * time.Millisecond).String()
+	}
+
+	return strconv.FormatInt(value, 10)
+}
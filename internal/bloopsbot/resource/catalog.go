@@ -0,0 +1,403 @@
+package resource
+
+import (
+	"strings"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/util"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+const (
+	LocaleRu = "ru"
+	LocaleEn = "en"
+
+	DefaultLocale = LocaleRu
+)
+
+// catalogs in the order they are offered to the players
+var catalogs = []*Catalog{Ru, En}
+
+// Lang returns the catalog for the telegram language code, e.g. "en-US", falls back to the default locale
+func Lang(code string) *Catalog {
+	code = strings.ToLower(code)
+	if idx := strings.IndexAny(code, "-_"); idx != -1 {
+		code = code[:idx]
+	}
+
+	for _, c := range catalogs {
+		if c.Locale == code {
+			return c
+		}
+	}
+
+	return Default()
+}
+
+func Default() *Catalog {
+	return Ru
+}
+
+// Locales returns all shipped catalogs
+func Locales() []*Catalog {
+	return catalogs
+}
+
+// Catalog is the set of user-facing texts and game resources of a locale
+type Catalog struct {
+	Locale string
+	Name   string
+
+	// game resources
+	Letters    []Letter
+	Categories []Category
+	Bloopses   []Bloops
+
+	// plural forms of the nouns, see util.Plural
+	NounPoints  []string
+	NounSeconds []string
+	NounWords   []string
+
+	// common menu button text
+	CreateButtonText      string
+	LeaveButtonText       string
+	StartButtonText       string
+	JoinButtonText        string
+	RatingButtonText      string
+	RuleButtonText        string
+	GameSettingButtonText string
+	ProfileButtonText     string
+	WatchButtonText       string
+	ManageButtonText      string
+	FindButtonText        string
+
+	// builder inline button text
+	BuilderInlineNextText string
+	BuilderInlinePrevText string
+	BuilderInlineDoneText string
+
+	// manage text messages
+	TextAuthorGreetingMsg                  string
+	TextJoinedGameMsg                      string
+	TextWatchingGameMsg                    string
+	TextFeedbackMsg                        string
+	TextFeedbackReceivedMsg                string
+	TextBanMsg                             string
+	TextBannedMsg                          string
+	TextBanUserNotFoundMsg                 string
+	TextBanAdminMsg                        string
+	TextUserBannedMsg                      string
+	TextAdminRequiredMsg                   string
+	TextGameRoomNotFoundMsg                string
+	TextSendJoinedCodeMsg                  string
+	TextInviteMsg                          string
+	TextLeavingSessionsMsg                 string
+	TextSendOfflinePlayerUsernameMsg       string
+	TextSendProfileMsg                     string
+	TextBuilderWarnMsg                     string
+	TextMatchWarnMsg                       string
+	TextProfileCmdUserNotFound             string
+	TextGameRoomNotFound                   string
+	TextOfflinePlayerAdded                 string
+	TextCreationGameCompletedSuccessfulMsg string
+	TextSettingsMsg                        string
+	TextGreetingMsg                        string
+	TextRulesMsg                           string
+	TextChatNotAllowed                     string
+
+	// profile
+	TextProfileTitle       string
+	TextProfileGames       string
+	TextProfileStars       string
+	TextProfileRating      string
+	TextProfileNotRated    string
+	TextProfileBloops      string
+	TextProfileBestTime    string
+	TextProfileAverageTime string
+	TextProfileBestPoints  string
+
+	// group chat text messages
+	TextGroupGameCreatedMsg  string
+	TextGroupGameExistsMsg   string
+	TextGroupGameNotFoundMsg string
+	TextGroupPlayerJoinedMsg string
+	TextGroupSpectatorMsg    string
+	TextGroupPlayerInGameMsg string
+	TextNotYourTurnMsg       string
+
+	// builder text messages
+	TextChooseLocale                string
+	TextChooseCategories            string
+	TextChooseRoundsNum             string
+	TextDeleteComplexLetters        string
+	TextVoteAllowed                 string
+	TextBloopsAllowed               string
+	TextTypedAnswersAllowed         string
+	TextSpectatorsVoteAllowed       string
+	TextOfflineVoteAllowed          string
+	TextConfigurationDone           string
+	TextAddLeastCategoryToComplete  string
+	TextAddLeastOneLetterToComplete string
+	TextAddedCategory               string
+	TextDeletedCategory             string
+	TextRoundsNumAnswer             string
+	TextAddedLetter                 string
+	TextDeletedLetter               string
+	TextVoteYes                     string
+	TextVoteNo                      string
+
+	// builder pack texts
+	TextChoosePack         string
+	TextPackChosenAnswer   string
+	TextPackNotFoundMsg    string
+	TextSavePackBtn        string
+	TextSendPackNameMsg    string
+	TextPackNameInvalidMsg string
+	TextPackSavedMsg       string
+
+	// builder bloopses texts
+	TextChooseBloopses          string
+	TextAddedBloops             string
+	TextDeletedBloops           string
+	TextBloopsFormatInvalidMsg  string
+	TextBloopsPointsInvalidMsg  string
+	TextBloopsSecondsInvalidMsg string
+	TextBloopsWeightInvalidMsg  string
+	TextChooseBloopsChance      string
+	TextBloopsChanceAnswer      string
+	TextBloopsRepeatOn          string
+	TextBloopsRepeatOff         string
+	TextChooseVoteRule          string
+	TextVoteRuleMajority        string
+	TextVoteRuleTwoThirds       string
+	TextVoteRuleUnanimous       string
+	TextVoteRulePartial         string
+	TextVoteTimeoutAnswer       string
+
+	// team text messages
+	TextChooseTeams       string
+	TextNoTeams           string
+	TextTeamName          string
+	TextTeamMsg           string
+	TextTeamChosen        string
+	TextTeamNotChangeable string
+	TextTeamMember        string
+	TextSettingsTeams     string
+
+	// moderation text messages
+	TextManageMsg          string
+	TextManageNotAllowed   string
+	TextManageKick         string
+	TextManageSkip         string
+	TextManagePause        string
+	TextManageResume       string
+	TextManagePoints       string
+	TextManageEnd          string
+	TextChoosePlayerMsg    string
+	TextPlayerKickedMsg    string
+	TextKickedMsg          string
+	TextTurnSkippedMsg     string
+	TextNoActiveTurnMsg    string
+	TextGamePausedMsg      string
+	TextGameUnpausedMsg    string
+	TextSendPointsMsg      string
+	TextPointsInvalidMsg   string
+	TextPointsChangedMsg   string
+	TextNoLastTurnMsg      string
+	TextGameEndedByHostMsg string
+	TextTimerPaused        string
+
+	// private game text messages
+	TextChoosePrivate      string
+	TextPIN                string
+	TextSettingsPrivate    string
+	TextSendPINMsg         string
+	TextWrongPINMsg        string
+	TextJoinRequestSentMsg string
+	TextJoinRequestMsg     string
+	TextWatchRequestMsg    string
+	TextJoinApprove        string
+	TextJoinDeny           string
+	TextJoinApprovedMsg    string
+	TextJoinDeniedMsg      string
+	TextJoinRequestDenied  string
+	TextJoinLockedMsg      string
+
+	// lobby text messages
+	TextChoosePublic      string
+	TextMaxPlayers        string
+	TextSettingsPublic    string
+	TextLobbyTitle        string
+	TextLobbyEntry        string
+	TextLobbyEmptyMsg     string
+	TextLobbyJoin         string
+	TextLobbyGoneMsg      string
+	TextGameFullMsg       string
+	TextQuickMatch        string
+	TextQueueJoinedMsg    string
+	TextQueueLeftMsg      string
+	TextMatchFoundMsg     string
+	TextMatchFoundHostMsg string
+
+	// leaderboard text messages
+	TextTopTitle    string
+	TextTopEntry    string
+	TextTopEmptyMsg string
+	TextTopPosition string
+	TextTopStars    string
+	TextTopPoints   string
+	TextTopTime     string
+	TextTopBloops   string
+	TextTopMonth    string
+	TextTopAllTime  string
+
+	// match text messages
+	TextLeaderboardHeader   string
+	TextRoundFavoriteMsg    string
+	TextClickStartBtnMsg    string
+	TextStartBtn            string
+	TextStopBtn             string
+	TextStartBtnDataAnswer  string
+	TextChallengeBtn        string
+	TextStopBtnDataAnswer   string
+	TextStartLetterMsg      string
+	TextNextPlayerMsg       string
+	TextHostedTurnMsg       string
+	TextOfflineVoteMsg      string
+	TextPlayerLeftGameMsg   string
+	TextPlayerJoinedGameMsg string
+	TextSpectatorJoinedMsg  string
+	TextStopPlayerRoundMsg  string
+	TextGameStarted         string
+	TextVoteMsg             string
+	TextVoteCancelledMsg    string
+	TextVoteKnownMsg        string
+	TextVoteAnswer          string
+	TextVoteNotAllowed      string
+	TextVoteAnswersHint     string
+	TextAnswerRejected      string
+	TextAnswerRestored      string
+	TextTypeAnswersMsg      string
+	TextAnswerAcceptedMsg   string
+	TextAnswerLetterMsg     string
+	TextAnswerDuplicateMsg  string
+	TextBroadcastCrashMsg   string
+	TextGameResumedMsg      string
+	TextStopButton          string
+	TextCheckBloopsMsg      string
+	TextBloopsNotDroppedMsg string
+	TextChallengeWarnMsg    string
+	TextStartWarnMsg        string
+	TextSkipTurnMsg         string
+	TextReadyMsg            string
+	TextSteadyMsg           string
+	TextGoMsg               string
+	TextRegularRound        string
+	TextUnknownCard         string
+	TextChooseCardMsg       string
+	TextCardFoundAnswer     string
+	TextCardEmptyAnswer     string
+	TextSecondsShort        string
+	TextYes                 string
+	TextNo                  string
+
+	// match render
+	TextDropBloopsTitle    string
+	TextDropBloopsFooter   string
+	TextStartRoundTitle    string
+	TextStartRoundTask     string
+	TextCategoriesTitle    string
+	TextGameFinishedTitle  string
+	TextSettingsTitle      string
+	TextSettingsRoundsNum  string
+	TextSettingsRoundTime  string
+	TextSettingsBloops     string
+	TextSettingsVote       string
+	TextSettingsSpectators string
+	TextSettingsOffline    string
+	TextSettingsAnswers    string
+	TextSettingsCategories string
+	TextPlayerGetPoints    string
+	TextStartHelpMsg       string
+	TextStartHelpLetter    string
+}
+
+// Plural returns the form of the noun for the number according to the rules of the locale
+// VoteRuleText returns the name of the vote rule
+func (c *Catalog) VoteRuleText(rule VoteRule) string {
+	switch rule {
+	case VoteRuleTwoThirds:
+		return c.TextVoteRuleTwoThirds
+	case VoteRuleUnanimous:
+		return c.TextVoteRuleUnanimous
+	case VoteRulePartial:
+		return c.TextVoteRulePartial
+	default:
+		return c.TextVoteRuleMajority
+	}
+}
+
+func (c *Catalog) Plural(n int, forms []string) string {
+	return util.Plural(c.Locale, n, forms...)
+}
+
+// BloopsNum returns the number of distinct bloopses, the same bloops is repeated in the list to raise its chance
+func (c *Catalog) BloopsNum() int {
+	names := make(map[string]struct{}, len(c.Bloopses))
+	for _, bloops := range c.Bloopses {
+		names[bloops.Name] = struct{}{}
+	}
+
+	return len(names)
+}
+
+func (c *Catalog) CreateButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.CreateButtonText)
+}
+
+func (c *Catalog) JoinButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.JoinButtonText)
+}
+
+func (c *Catalog) WatchButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.WatchButtonText)
+}
+
+func (c *Catalog) FindButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.FindButtonText)
+}
+
+func (c *Catalog) LeaveButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.LeaveButtonText)
+}
+
+func (c *Catalog) StartButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.StartButtonText)
+}
+
+func (c *Catalog) RatingButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.RatingButtonText)
+}
+
+func (c *Catalog) RulesButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.RuleButtonText)
+}
+
+func (c *Catalog) ProfileButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.ProfileButtonText)
+}
+
+func (c *Catalog) ManageButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.ManageButtonText)
+}
+
+func (c *Catalog) GameSettingButton() tgbotapi.KeyboardButton {
+	return tgbotapi.NewKeyboardButton(c.GameSettingButtonText)
+}
+
+func (c *Catalog) CommonButtons() tgbotapi.ReplyKeyboardMarkup {
+	return tgbotapi.NewReplyKeyboard(
+		tgbotapi.NewKeyboardButtonRow(c.CreateButton(), c.FindButton()),
+		tgbotapi.NewKeyboardButtonRow(c.JoinButton(), c.WatchButton()),
+		tgbotapi.NewKeyboardButtonRow(c.RulesButton(), c.ProfileButton()),
+	)
+}
@@ -6,6 +6,17 @@ var (
 	CmdRules     = "/rules"
 	CmdAddPlayer = "/add"
 	CmdProfile   = "/profile"
+	CmdTop       = "/top"
 	CmdFeedback  = "/feedback"
 	CmdBan       = "/ban"
+
+	// group chat commands
+	CmdGroupGame  = "/game"
+	CmdGroupJoin  = "/join"
+	CmdGroupStart = "/play"
+	CmdGroupLeave = "/leave"
+	CmdGroupWatch = "/watch"
+
+	// the author manages the running game
+	CmdGroupManage = "/manage"
 )
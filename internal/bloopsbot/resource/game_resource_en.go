@@ -0,0 +1,99 @@
+package resource
+
+import "github.com/enescakir/emoji"
+
+var (
+	enLetters = []Letter{
+		{Text: "A", Status: true},
+		{Text: "B", Status: true},
+		{Text: "C", Status: true},
+		{Text: "D", Status: true},
+		{Text: "E", Status: true},
+		{Text: "F", Status: true},
+		{Text: "G", Status: true},
+		{Text: "H", Status: true},
+		{Text: "I", Status: true},
+		{Text: "J"},
+		{Text: "K"},
+		{Text: "L", Status: true},
+		{Text: "M", Status: true},
+		{Text: "N", Status: true},
+		{Text: "O", Status: true},
+		{Text: "P", Status: true},
+		{Text: "Q"},
+		{Text: "R", Status: true},
+		{Text: "S", Status: true},
+		{Text: "T", Status: true},
+		{Text: "U"},
+		{Text: "V"},
+		{Text: "W", Status: true},
+		{Text: "X"},
+		{Text: "Y"},
+		{Text: "Z"},
+	}
+
+	enCategories = []Category{
+		{Text: "Country"},
+		{Text: "City", Status: true},
+		{Text: "Fruit or vegetable", Status: true},
+		{Text: "Name", Status: true},
+		{Text: "Celebrity"},
+		{Text: "Brand", Status: true},
+		{Text: "Animal", Status: true},
+		{Text: "Term"},
+		{Text: "Any word"},
+	}
+
+	enBloopses = []Bloops{
+		{Name: emoji.Cinema.String() + " Arthouse director", Weight: 2, Seconds: +30, Points: +10, Task: "An arthouse film director burst in and asked you to help with the project, replace the categories of the game with *films and actors*\nName films, actors or directors starting with the drawn letter"},
+		{Name: emoji.Flamingo.String() + " Flamingo", Weight: 2, Points: +10, Task: "Somehow you turned into a flamingo for a while, stand on one leg while naming the words(you may hold on to something)"},
+		{Name: emoji.Flamingo.String() + " Flamingo", Weight: 2, Points: +10, Task: "Somehow you turned into a flamingo for a while, stand on one leg while naming the words(you may hold on to something)"},
+		{Name: emoji.WomanSinger.String() + " Opening act", Weight: 2, Points: +5, Task: "You are a rising rock star asked to open the show, sing every word you name in your favorite style"},
+		{Name: emoji.WomanSinger.String() + " Opening act", Weight: 2, Points: +5, Task: "You are a rising rock star asked to open the show, sing every word you name in your favorite style"},
+		{Name: emoji.Hammer.String() + " Craftsmanship", Weight: 1, Points: +20, Seconds: +20, Task: "Your craft has been passed down for generations, time to show it at the fair! Name two words for each category instead of one"},
+		{Name: emoji.ManLiftingWeights.String() + " Bodybuilder", Weight: 2, Seconds: +8, Points: +10, Task: "Now you master not only words but your body too, squat once after each word you name"},
+		{Name: emoji.PeopleWithBunnyEars.String() + " Teamwork", Weight: 1, Points: +8, Task: "You finish each other's words and nobody can beat you! Time for teamwork! The neighbor on your right names the words with you in turns, you go first"},
+		{Name: emoji.PersonRunning.String() + " Flash", Weight: 2, Points: +15, Seconds: -5, Task: "They call you the fastest man alive, you have 5 sec less this round, show the power of speed!"},
+		{Name: emoji.ManDancing.String() + " Disco", Weight: 2, Seconds: +5, Points: +20, Task: "Are you in for dancing? Here are the terms, one of the players plays you a song and you dance for exactly 20 sec(time it), then press stop on the timer and get +20 points, so are you in?"},
+		{Name: emoji.WaterWave.String() + " Wave of luck", Weight: 2, Points: +10, Task: "A wave of luck has covered you, just ride it and do your thing"},
+		{Name: emoji.WaterWave.String() + " Wave of luck", Weight: 2, Points: +10, Task: "A wave of luck has covered you, just ride it and do your thing"},
+		{Name: emoji.WomanGesturingNo.String() + " Bad luck", Weight: 2, Points: -5, Task: "Got out of bed on the wrong side, ate off a knife and spilled the salt, whatever you do this round turns out a bit worse"},
+		{Name: emoji.WomanGesturingNo.String() + " Bad luck", Weight: 2, Points: -5, Task: "Got out of bed on the wrong side, ate off a knife and spilled the salt, whatever you do this round turns out a bit worse"},
+		{Name: emoji.LoudlyCryingFace.String() + " Depression", Weight: 1, Seconds: +10, Points: -10, Task: "Too much overtime on weekends ended up in a long depression, but friends come to help, the player opposite you plays this round for you, you can hand them your phone"},
+		{Name: emoji.IceHockey.String() + " Substitution", Weight: 2, Task: "Like a hockey team captain you see the weak links right away and change the strategy. You may replace one of the hard(in your opinion) categories with another one, then you need to name 2 words for the substituting one"},
+		{Name: emoji.Bowling.String() + " Strike", Weight: 2, Points: +7, Task: "After a series of misses you finally rolled a strike and knocked down all the pins, name words for just one category of your choice"},
+		{Name: emoji.Bomb.String() + " Bomb", Weight: 1, Points: +10, Task: "Boom! Something exploded, name a word for one of the categories twice, any of them"},
+		{Name: emoji.ManKneeling.String() + " Proposal", Weight: 2, Points: +5, Task: "Looks like the moment you have been waiting for has come, name the words down on one knee!"},
+		{Name: emoji.ManKneeling.String() + " Proposal", Weight: 2, Points: +5, Task: "Looks like the moment you have been waiting for has come, name the words down on one knee!"},
+		{Name: emoji.Divide.String() + " Mathematician", Weight: 2, Seconds: +13, Points: +15, Task: "Suddenly you became an accountant among barbarians and many consider you a great scholar, time to prove it, every time you name a word say the remaining seconds multiplied by 2. For example, if 17 are left -> 34, if 23 -> 46"},
+		{Name: emoji.ClappingHands.String() + " Applause", Weight: 2, Points: +10, Task: "You are finally on Broadway and the audience loves you, a task for the other players, clap your hands whenever the player names a word starting with the drawn letter"},
+		{Name: emoji.ClappingHands.String() + " Applause", Weight: 2, Points: +10, Task: "You are finally on Broadway and the audience loves you, a task for the other players, clap your hands whenever the player names a word starting with the drawn letter"},
+		{Name: emoji.Ninja.String() + " Samurai", Weight: 2, Seconds: -10, Points: +20, Task: "Like a samurai you are ready for anything, victory or death, you have 10 sec less but get +20 points as a reward"},
+		{Name: emoji.SeeNoEvilMonkey.String() + " Blackout", Weight: 2, Points: +5, Task: "The world has sunk into darkness, but you are ready for it! Name the words with your eyes closed, you can do it!"},
+		{Name: emoji.SeeNoEvilMonkey.String() + " Blackout", Weight: 2, Points: +5, Task: "The world has sunk into darkness, but you are ready for it! Name the words with your eyes closed, you can do it!"},
+		{Name: emoji.Guitar.String() + " Musicality", Weight: 2, Points: +5, Task: "You follow the sound of music like a fantasy hero with your own bard composing ballads about you, one of the players turns on any song to play the round to, not at full volume of course"},
+		{Name: emoji.Guitar.String() + " Musicality", Weight: 2, Points: +5, Task: "You follow the sound of music like a fantasy hero with your own bard composing ballads about you, one of the players turns on any song to play the round to, not at full volume of course"},
+		{Name: emoji.MartialArtsUniform.String() + " Karate", Weight: 1, Points: +10, Seconds: +10, Task: "You trained for a long time and became a martial arts master, after each word make a karate strike with a matching sound. Don't try too hard, it's not an exam"},
+		{Name: emoji.FourLeafClover.String() + " Four-leaf clover", Weight: 2, Task: "Walking in the woods one day you saw it - a four-leaf clover. Luck! You may replace the drawn letter with any other"},
+		{Name: emoji.UmbrellaWithRainDrops.String() + " Bad weather", Weight: 2, Seconds: -5, Task: "Bad weather, or mood, or someone yelled at you on the bus, anyway you have lost 5 sec, find a way out"},
+		{Name: emoji.Rainbow.String() + " Rainbow", Weight: 2, Task: "You went out and saw a rainbow, it was a sign you are on the right track, you may exclude one category of your choice this round"},
+		{Name: emoji.Unicorn.String() + " Unicorn", Weight: 1, Seconds: +7, Points: +7, Task: "One day you opened the door and a unicorn on the doorstep demanded to replace the drawn letter with E this round, you happily agreed"},
+		{Name: emoji.Snail.String() + " Snail", Weight: 2, Seconds: +10, Points: -10, Task: "There are days when you are like a snail, plenty of time but no use, same this round!"},
+		{Name: emoji.Mage.String() + " Mage", Weight: 2, Seconds: +15, Points: +10, Task: "A mage came out of a portal and says that to win you also need to name one magic or fantasy word starting with the drawn letter"},
+		{Name: emoji.RightFacingFist.String() + emoji.VictoryHand.String() + emoji.RaisedBackOfHand.String() + " Rock, paper, scissors", Weight: 2, Points: +10, Task: "You feel like a child again and argue about who goes first, play rock, paper, scissors with the neighbor on your left, the winner plays the round. The points go to you"},
+		{Name: emoji.GemStone.String() + emoji.Owl.String() + " Quiz night", Weight: 2, Seconds: -10, Points: +20, Task: "You gathered a team and became its captain, you name the words and the other players should help you to finish the round faster"},
+		{Name: emoji.Ship.String() + " In the same boat", Weight: 2, Seconds: +15, Task: "The ship is sinking! Teamwork is needed, all players name the words in turns. The active player starts, then the player on the left and so on clockwise, go!"},
+		{Name: emoji.DivingMask.String() + " Scuba diver", Weight: 2, Points: +10, Task: "You went scuba diving and got caught off guard. Say the words holding your nose with one hand!"},
+		{Name: emoji.DivingMask.String() + " Scuba diver", Weight: 2, Points: +10, Task: "You went scuba diving and got caught off guard. Say the words holding your nose with one hand!"},
+		{Name: emoji.HighVoltage.String() + " High voltage", Weight: 1, Seconds: +15, Points: +15, Task: "You are in the final of a TV quiz show, come up with the words and name them all at once when 10 sec are left on the timer!"},
+		{Name: emoji.WomanRunning.String() + " Fitness coach", Weight: 2, Points: +5, Task: "You have a personal coach who may change the program, let it be the player on your right, they may pick an easier letter instead of the drawn one!"},
+		{Name: emoji.ManBouncingBall.String() + " Athlete", Weight: 2, Points: +5, Task: "You are a champion of an intellectual club and solve problems with squats! If you can't come up with a word - squat once, next time twice and so on"},
+		{Name: emoji.Brain.String() + " Flowers for Algernon", Weight: 1, Seconds: +30, Points: +10, Task: "Your IQ has risen dramatically for a short time and you decide to amaze everyone, name ONE word this round that ends with the drawn letter instead of starting with it!"},
+		{Name: emoji.CrystalBall.String() + " Fortune teller", Weight: 2, Task: "You decided to try astrology, before the round the player opposite you picks one of the game categories, if you guess it you win the round right away(press stop on the timer right after the start), if not you play as usual"},
+		{Name: emoji.CrystalBall.String() + " Fortune teller", Weight: 2, Task: "You decided to try astrology, before the round the player opposite you picks one of the game categories, if you guess it you win the round right away(press stop on the timer right after the start), if not you play as usual"},
+		{Name: emoji.CrystalBall.String() + " Fortune teller", Weight: 2, Task: "You decided to try astrology, before the round the player opposite you picks one of the game categories, if you guess it you win the round right away(press stop on the timer right after the start), if not you play as usual"},
+		{Name: emoji.MoneyBag.String() + " Casino", Weight: 2, Task: "You like a game of cards and the casino offers you a deal, toss a coin, heads - you win the round right away(press stop on the timer right after the start), tails - you lose(wait for the timer and don't play) What do you choose?"},
+		{Name: emoji.MoneyBag.String() + " Casino", Weight: 2, Task: "You like a game of cards and the casino offers you a deal, toss a coin, heads - you win the round right away(press stop on the timer right after the start), tails - you lose(wait for the timer and don't play) What do you choose?"},
+		{Name: emoji.TestTube.String() + " Mamihlapinatapai", Weight: 2, Task: "You accidentally ended up at a student party and they offered a challenge, you may say the word Mamihlapinatapai 5 times in a row and press stop on the timer or play the round as usual. Your choice"},
+	}
+)
@@ -0,0 +1,325 @@
+package resource
+
+import "github.com/enescakir/emoji"
+
+var En = &Catalog{
+	Locale: LocaleEn,
+	Name:   "English",
+
+	Letters:    enLetters,
+	Categories: enCategories,
+	Bloopses:   enBloopses,
+
+	NounPoints:  []string{"point", "points"},
+	NounSeconds: []string{"second", "seconds"},
+	NounWords:   []string{"word", "words"},
+
+	// common menu button text
+	CreateButtonText:      emoji.Fire.String() + " Create game",
+	LeaveButtonText:       emoji.ChequeredFlag.String() + " Leave",
+	StartButtonText:       emoji.Rocket.String() + " Start",
+	JoinButtonText:        emoji.VideoGame.String() + " Join game",
+	RatingButtonText:      emoji.Star.String() + " Leaderboard",
+	RuleButtonText:        "Rules",
+	GameSettingButtonText: "Game settings",
+	ProfileButtonText:     emoji.Alien.String() + " Profile",
+	WatchButtonText:       emoji.Eyes.String() + " Watch game",
+	ManageButtonText:      emoji.HammerAndWrench.String() + " Manage game",
+	FindButtonText:        emoji.MagnifyingGlassTiltedLeft.String() + " Find a game",
+
+	// builder inline button text
+	BuilderInlineNextText: "Next",
+	BuilderInlinePrevText: "Back",
+	BuilderInlineDoneText: emoji.ChequeredFlag.String() + " Finish",
+
+	// manage text messages
+	TextAuthorGreetingMsg: "\n\nYou are the host " + emoji.FlexedBiceps.String() + "\n\n" +
+		"When all players have joined press\n" + emoji.Rocket.String() + " *Start* " + " to begin",
+	TextJoinedGameMsg:                "You have joined the game! ",
+	TextWatchingGameMsg:              "You are watching the game, you will see its progress and the leaderboard ",
+	TextFeedbackMsg:                  "You can send anonymous feedback",
+	TextFeedbackReceivedMsg:          "Feedback from a user: %s",
+	TextBanMsg:                       "Send the username of the user",
+	TextBannedMsg:                    "Banned",
+	TextBanUserNotFoundMsg:           "User not found: %s",
+	TextBanAdminMsg:                  "An administrator can't be banned",
+	TextUserBannedMsg:                "User banned: %s",
+	TextAdminRequiredMsg:             "This command requires administrator rights",
+	TextGameRoomNotFoundMsg:          "Game room not found",
+	TextSendJoinedCodeMsg:            "Send the game code",
+	TextInviteMsg:                    "Scan the QR code or share the link to join the game: %s",
+	TextLeavingSessionsMsg:           "You have left all game sessions",
+	TextSendOfflinePlayerUsernameMsg: "Send the name of the offline player",
+	TextSendProfileMsg:               "Send the @username of the user",
+	TextBuilderWarnMsg: emoji.BrokenHeart.String() + " Sorry, the " + emoji.Robot.String() +
+		" bot is updating, the game setting will continue in a few minutes",
+	TextMatchWarnMsg: emoji.BrokenHeart.String() + " Sorry, the " + emoji.Robot.String() +
+		" bot is updating, this round will restart in a few seconds!",
+	TextProfileCmdUserNotFound: "User not found",
+	TextGameRoomNotFound:       "You need to join a game to add offline players",
+	TextOfflinePlayerAdded:     "Offline player added. All their messages will be sent to you",
+	TextCreationGameCompletedSuccessfulMsg: emoji.Unicorn.String() + " The game room is created.\n\nTo enter it press " +
+		emoji.VideoGame.String() + " *Join game* and send this code.\n\n" +
+		emoji.PartyingFace.String() + " Share the code with the people you are going to play with",
+
+	TextSettingsMsg: emoji.Gear.String() + " Setting up the game",
+
+	TextGreetingMsg: emoji.ChristmasTree.String() + emoji.ChristmasTree.String() + emoji.ChristmasTree.String() + "Hi, %s\n\n" +
+		"This is " + `@blooops\_bot` + emoji.Robot.String() + " - a bot for small quizzes where players have " + emoji.Stopwatch.String() + " 30 sec " +
+		"to name one word for each of several categories starting with a random letter\n\n" +
+		"The bot" + emoji.Robot.String() + " hosts offline games." +
+		" It counts points, draws letters, builds leaderboards and keeps the rules, while you play!" + emoji.Unicorn.String() + "\n\n" +
+		"*Rules:* " + CmdRules + "\n\n" +
+		"*Feedback:* @robotomize\n" +
+		"*Project on github:* [bloops_bot](https://github.com/robotomize/bloopsbot)",
+
+	TextRulesMsg: emoji.Bookmark.String() + " *Rules*\n\n" +
+		"Players have " + emoji.Stopwatch.String() + " 30 sec " +
+		"to name one word for each of several categories starting with a random letter\n" +
+		"After several rounds the players with the most points win" + emoji.Trophy.String() + "\n\n" +
+		emoji.CrossMark.String() + " *Limits* - 2 players or more, " + `@bloopsbot\_bot ` + emoji.Robot.String() + " hosts offline games\n\n" +
+		emoji.Joystick.String() + " *How to play?* - \nfirst the host should " + emoji.Fire.String() + " *Create game* and set it up." +
+		" The host gets a code to share with the players. Then the players " +
+		"join the game and the host presses \n" + emoji.Rocket.String() + " *Start*\n\n" +
+		emoji.Loudspeaker.String() + " *Voting* - after each round the players decide whether the player completed the task, if not, the player gets no points for the round\n\n" +
+		emoji.GemStone.String() + " *Bloopses* - extra tasks " +
+		"to complete along with the main game, a player gets one with some chance \n\n" +
+		"*Commands:* \n" +
+		"/start - sets up the bot and sends a short introduction\n" +
+		"/rules - sends the rules\n" +
+		"/feedback - send anonymous feedback\n" +
+		"/profile - view the profile of another player\n" +
+		"/top - the best players of the month and of all time\n" +
+		"/add - once you have joined a game room you can add players without telegram, so called virtual players, their tasks will be sent to you. Hand them your phone when it is their turn\n\n" +
+		"*Playing in a group:* \n" +
+		"/game - create a game in a group chat, the timer, letters and results are sent to the group\n" +
+		"/join - join the game in a group chat\n" +
+		"/play - start the game, only for the author\n" +
+		"/manage - kick players, skip turns, pause, change points or end the game, only for the author\n" +
+		"/leave - leave the game in a group chat\n\n" +
+		"*Feedback:* @robotomize\n" +
+		"*Project on github:* [bloops_bot](https://github.com/robotomize/bloopsbot)",
+	TextChatNotAllowed: emoji.WomanGesturingNo.String() + " This command works only in a private chat with the bot",
+
+	// profile
+	TextProfileTitle:       "%s Player profile *%s*\n\n",
+	TextProfileGames:       "%s Played: %s\n",
+	TextProfileStars:       "%s Wins: %s\n",
+	TextProfileRating:      "%s Rating: %d ± %d (%s)\n",
+	TextProfileNotRated:    "%s Rating: not rated yet\n",
+	TextProfileBloops:      "%s Bloopses unlocked: %s/%s\n",
+	TextProfileBestTime:    "%s Best round time: %s\n",
+	TextProfileAverageTime: "%s Average round time: %s\n",
+	TextProfileBestPoints:  "%s Best round score: %s",
+
+	// group chat text messages
+	TextGroupGameCreatedMsg: emoji.Unicorn.String() + " The game room is created in this chat.\n\n" +
+		"Send " + CmdGroupJoin + " to join or " + CmdGroupWatch + " to watch, the author starts the game with " + CmdGroupStart,
+	TextGroupGameExistsMsg:   "A game is already being set up or played in this chat",
+	TextGroupGameNotFoundMsg: "There is no game in this chat, create one with " + CmdGroupGame,
+	TextGroupPlayerJoinedMsg: "%s joined the game",
+	TextGroupSpectatorMsg:    "%s is watching the game",
+	TextGroupPlayerInGameMsg: "%s, you are already playing another game",
+	TextNotYourTurnMsg:       "It's not your turn",
+
+	// builder text messages
+	TextChooseLocale:                "Choose the game language",
+	TextChooseCategories:            "Choose categories or type your own",
+	TextChooseRoundsNum:             "Choose the number of rounds(1 by default)",
+	TextDeleteComplexLetters:        "Remove hard letters",
+	TextVoteAllowed:                 emoji.Loudspeaker.String() + " Add voting?\n\nMore: /rules",
+	TextBloopsAllowed:               emoji.GemStone.String() + " Add bloopses?\n\nMore: /rules",
+	TextTypedAnswersAllowed:         emoji.Keyboard.String() + " Type the answers in the chat?\n\nThe player types the words during the timer, the bot checks the letter and the repeats, every accepted word gives %d points",
+	TextSpectatorsVoteAllowed:       emoji.Eyes.String() + " Can the spectators vote?",
+	TextOfflineVoteAllowed:          emoji.MobilePhone.String() + " Do the offline players vote? Their host gets a separate vote for each of them",
+	TextConfigurationDone:           "Finish setting up the game?",
+	TextAddLeastCategoryToComplete:  "More categories are required",
+	TextAddLeastOneLetterToComplete: "Add at least one letter to finish",
+	TextAddedCategory:               "Category %s added",
+	TextDeletedCategory:             "Category %s removed",
+	TextRoundsNumAnswer:             "Rounds - %d",
+	TextAddedLetter:                 "Letter %s added",
+	TextDeletedLetter:               "Letter %s removed",
+	TextVoteYes:                     emoji.ThumbsUp.String() + " Yes",
+	TextVoteNo:                      emoji.ThumbsDown.String() + " No",
+
+	// builder pack text messages
+	TextChoosePack: emoji.Package.String() + " Choose one of your packs of categories and letters or send the code of a shared pack\n\n" +
+		"Press Next to keep the standard ones",
+	TextPackChosenAnswer:   "Pack %s chosen",
+	TextPackNotFoundMsg:    "Pack with code %s not found",
+	TextSavePackBtn:        emoji.FloppyDisk.String() + " Save as pack",
+	TextSendPackNameMsg:    "Send a name for the pack",
+	TextPackNameInvalidMsg: "The pack name should be from 1 to %d characters long",
+	TextPackSavedMsg:       emoji.Package.String() + " Pack \"%s\" saved\n\nShare the code %d with friends to use it in their games",
+
+	// builder bloopses text messages
+	TextChooseBloopses: emoji.GemStone.String() + " Choose the bloopses for the game or add your own, send a message:\n\n" +
+		"name; task; points; seconds; weight\n\n" +
+		"Points from %d to %d, seconds from %d to %d, weight from %d to %d, seconds and weight are optional",
+	TextAddedBloops:             "Bloops %s added",
+	TextDeletedBloops:           "Bloops %s removed",
+	TextBloopsFormatInvalidMsg:  "Send the bloops as: name; task; points; seconds; weight",
+	TextBloopsPointsInvalidMsg:  "Bloops points should be from %d to %d",
+	TextBloopsSecondsInvalidMsg: "Bloops seconds should be from %d to %d",
+	TextBloopsWeightInvalidMsg:  "Bloops weight should be from %d to %d",
+	TextChooseBloopsChance: emoji.GameDie.String() + " Choose the chance that a bloops drops before a turn " +
+		"and whether the same bloops can drop again",
+	TextBloopsChanceAnswer: "Chance - %d%%",
+	TextBloopsRepeatOn:     emoji.RepeatButton.String() + " Bloopses can repeat",
+	TextBloopsRepeatOff:    emoji.RepeatSingleButton.String() + " Bloopses don't repeat",
+	TextChooseVoteRule: emoji.BalanceScale.String() + " Choose when the player loses the points of the round " +
+		"and how long the players can vote",
+	TextVoteRuleMajority:  "Most of the votes are against",
+	TextVoteRuleTwoThirds: "Two thirds of the votes are against",
+	TextVoteRuleUnanimous: "All the votes are against",
+	TextVoteRulePartial:   "Points by the share of the votes for",
+	TextVoteTimeoutAnswer: "Voting time - %d sec",
+
+	// team text messages
+	TextChooseTeams:       emoji.Family.String() + " Play in teams? Choose the number of teams or type the team names one per message",
+	TextNoTeams:           "No teams",
+	TextTeamName:          "Team %d",
+	TextTeamMsg:           "You play for *%s*, you can switch the team before the game starts",
+	TextTeamChosen:        "Your team: %s",
+	TextTeamNotChangeable: "The game has already started",
+	TextTeamMember:        "    %s, %d %s, %d/%d\n",
+	TextSettingsTeams:     "%s Teams: ",
+
+	// moderation text messages
+	TextManageMsg:          emoji.HammerAndWrench.String() + " Game management, the players see every action",
+	TextManageNotAllowed:   "Only the host can manage the game",
+	TextManageKick:         emoji.NoEntry.String() + " Kick a player",
+	TextManageSkip:         emoji.NextTrackButton.String() + " Skip the turn",
+	TextManagePause:        emoji.PauseButton.String() + " Pause",
+	TextManageResume:       emoji.PlayButton.String() + " Resume",
+	TextManagePoints:       emoji.Pencil.String() + " Change the last points",
+	TextManageEnd:          emoji.ChequeredFlag.String() + " End the game",
+	TextChoosePlayerMsg:    "Choose the player to kick",
+	TextPlayerKickedMsg:    emoji.NoEntry.String() + " The host removed %s from the game",
+	TextKickedMsg:          "The host removed you from the game",
+	TextTurnSkippedMsg:     emoji.NextTrackButton.String() + " The host skipped the turn of %s",
+	TextNoActiveTurnMsg:    "Nobody is playing a turn now",
+	TextGamePausedMsg:      emoji.PauseButton.String() + " The host paused the game, the timers are stopped",
+	TextGameUnpausedMsg:    emoji.PlayButton.String() + " The host resumed the game",
+	TextSendPointsMsg:      "Send the new points of %s for the last turn, now %d",
+	TextPointsInvalidMsg:   "The points should be a number from 0 to %d",
+	TextPointsChangedMsg:   emoji.Pencil.String() + " The host changed the points of %s for the last turn: %d → %d",
+	TextNoLastTurnMsg:      "No turn has been played yet",
+	TextGameEndedByHostMsg: emoji.ChequeredFlag.String() + " The host ended the game",
+	TextTimerPaused:        "paused",
+
+	// private game text messages
+	TextChoosePrivate:      emoji.Locked.String() + " Private game? You approve every join request. Type a PIN to ask the players for it as well",
+	TextPIN:                "PIN: %s",
+	TextSettingsPrivate:    "%s Private game: ",
+	TextSendPINMsg:         emoji.Key.String() + " Send the PIN of the game",
+	TextWrongPINMsg:        emoji.CrossMark.String() + " Wrong PIN",
+	TextJoinRequestSentMsg: emoji.HourglassNotDone.String() + " The join request was sent to the author of the game, wait for the answer",
+	TextJoinRequestMsg:     emoji.RaisingHands.String() + " *%s* asks to join the game",
+	TextWatchRequestMsg:    emoji.Eyes.String() + " *%s* asks to watch the game",
+	TextJoinApprove:        emoji.CheckMarkButton.String() + " Let in",
+	TextJoinDeny:           emoji.CrossMark.String() + " Deny",
+	TextJoinApprovedMsg:    emoji.CheckMarkButton.String() + " *%s* was let in",
+	TextJoinDeniedMsg:      emoji.NoEntry.String() + " The author of the game declined your request",
+	TextJoinRequestDenied:  emoji.CrossMark.String() + " *%s* was denied",
+	TextJoinLockedMsg:      emoji.NoEntry.String() + " Too many attempts to join, try again in %d min",
+
+	// lobby text messages
+	TextChoosePublic:      emoji.GlobeWithMeridians.String() + " Public game? Anyone can find it in the list of the games. Choose the most players",
+	TextMaxPlayers:        "%d players",
+	TextSettingsPublic:    "%s Public game, up to %d players",
+	TextLobbyTitle:        emoji.GlobeWithMeridians.String() + " *Public games*\n\n",
+	TextLobbyEntry:        "*#%d* · %s %s · %s %d min\n",
+	TextLobbyEmptyMsg:     emoji.GlobeWithMeridians.String() + " No public games are waiting for the players right now. Try the quick match!",
+	TextLobbyJoin:         "Join #%d",
+	TextLobbyGoneMsg:      "The game has already started",
+	TextGameFullMsg:       emoji.NoEntry.String() + " The game is full",
+	TextQuickMatch:        emoji.GameDie.String() + " Quick match",
+	TextQueueJoinedMsg:    emoji.GameDie.String() + " Looking for the players: %d of %d are waiting. Press the quick match again to stop",
+	TextQueueLeftMsg:      "You stopped looking for the players",
+	TextMatchFoundMsg:     emoji.GameDie.String() + " The players are found! *%s* starts the game",
+	TextMatchFoundHostMsg: emoji.GameDie.String() + " The players are found! You are the host, press start when everyone is ready",
+
+	// leaderboard text messages
+	TextTopTitle:    "%s *%s* · %s\n\n",
+	TextTopEntry:    "%s *%s* - %s\n",
+	TextTopEmptyMsg: "Nobody is on the leaderboard yet, play a game to be the first!\n",
+	TextTopPosition: "\nYour place: *%d* - %s",
+	TextTopStars:    "Wins",
+	TextTopPoints:   "Points",
+	TextTopTime:     "Best round time",
+	TextTopBloops:   "Bloopses",
+	TextTopMonth:    "This month",
+	TextTopAllTime:  "All time",
+
+	// match text messages
+	TextLeaderboardHeader:   "*Game results*\n\n",
+	TextRoundFavoriteMsg:    emoji.ChequeredFlag.String() + " Round %d is over",
+	TextClickStartBtnMsg:    emoji.ChequeredFlag.String() + " Press the button when you are ready",
+	TextStartBtn:            "I'm ready!",
+	TextStopBtn:             "Stop",
+	TextStartBtnDataAnswer:  "Go!",
+	TextChallengeBtn:        "Got it",
+	TextStopBtnDataAnswer:   "Stop!",
+	TextStartLetterMsg:      "Words starting with - ",
+	TextNextPlayerMsg:       "*%s* - your turn",
+	TextHostedTurnMsg:       emoji.MobilePhone.String() + " It's *%s*'s turn, hand over the phone",
+	TextOfflineVoteMsg:      "%s votes\n\n",
+	TextPlayerLeftGameMsg:   "Player %s left the game",
+	TextPlayerJoinedGameMsg: "Player %s joined the game",
+	TextSpectatorJoinedMsg:  "%s is watching the game",
+	TextStopPlayerRoundMsg:  "Done! You scored %d %s!",
+	TextGameStarted:         "The game has started!",
+	TextVoteMsg:             "Voting, did the player name everything right?",
+	TextVoteCancelledMsg:    "The player didn't complete the task, voting is cancelled",
+	TextVoteKnownMsg:        "All the words are in the dictionary, no voting needed",
+	TextVoteAnswer:          "Your vote: %s",
+	TextVoteNotAllowed:      "You can't vote in this round",
+	TextVoteAnswersHint:     "Press a wrong word to reject it, the other words keep their points",
+	TextAnswerRejected:      "Rejected: %s",
+	TextAnswerRestored:      "Taken back: %s",
+	TextTypeAnswersMsg:      emoji.Keyboard.String() + " Type the words one per line, they fill the categories in order, start the line with the number of the category to answer another one",
+	TextAnswerAcceptedMsg:   emoji.CheckMarkButton.String() + " %s: %s",
+	TextAnswerLetterMsg:     emoji.CrossMark.String() + " %s doesn't start with %s",
+	TextAnswerDuplicateMsg:  emoji.CrossMark.String() + " %s has already been named in this game",
+	TextBroadcastCrashMsg:   "The game was aborted due to a service error, please create a new game",
+	TextGameResumedMsg:      emoji.PlayButton.String() + " The game is resumed after the bot restart",
+	TextStopButton:          "Press Stop when you are done",
+	TextCheckBloopsMsg:      "Will a bloops drop?",
+	TextBloopsNotDroppedMsg: emoji.GameDie.String() + " No bloops this time",
+	TextChallengeWarnMsg:    "Player %s has to press Got it within %d sec",
+	TextStartWarnMsg:        "Player %s has to press the start button within %d sec",
+	TextSkipTurnMsg:         "%s didn't start the round within %d sec and skips the turn",
+	TextReadyMsg:            "Ready",
+	TextSteadyMsg:           "Steady",
+	TextGoMsg:               "Go!",
+	TextRegularRound:        "Regular round",
+	TextUnknownCard:         emoji.GemStone.String() + " Unknown card",
+	TextChooseCardMsg:       "Pick a card, you may get a bloops",
+	TextCardFoundAnswer:     "Found it!",
+	TextCardEmptyAnswer:     "Nothing here!",
+	TextSecondsShort:        "sec",
+	TextYes:                 "yes",
+	TextNo:                  "no",
+
+	// match render
+	TextDropBloopsTitle:    "%s *BLOOPS!*\n\n",
+	TextDropBloopsFooter:   "\n\nTell the players about the bloops and try to complete it",
+	TextStartRoundTitle:    "%s Ready to play?\n\n",
+	TextStartRoundTask:     "Name a word for every category starting with the drawn letter\n\n",
+	TextCategoriesTitle:    "%s Categories:\n\n",
+	TextGameFinishedTitle:  "%s The game is over\n\n*Winners*\n\n",
+	TextSettingsTitle:      "%s *Settings*\n\n",
+	TextSettingsRoundsNum:  "%s  Rounds: %s\n",
+	TextSettingsRoundTime:  "%s Round time: %s sec\n",
+	TextSettingsBloops:     "%s Bloopses: ",
+	TextSettingsVote:       "%s Voting: ",
+	TextSettingsSpectators: ", spectators vote",
+	TextSettingsOffline:    ", offline players vote",
+	TextSettingsAnswers:    "%s Typed answers: ",
+	TextSettingsCategories: "%s Categories\n",
+	TextPlayerGetPoints:    "%s scores %d %s",
+	TextStartHelpMsg:       "Player  %s has to name words:\n\n",
+	TextStartHelpLetter:    "Starting with: *%s*",
+}
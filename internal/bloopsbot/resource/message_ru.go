@@ -0,0 +1,325 @@
+package resource
+
+import "github.com/enescakir/emoji"
+
+var Ru = &Catalog{
+	Locale: LocaleRu,
+	Name:   "Русский",
+
+	Letters:    ruLetters,
+	Categories: ruCategories,
+	Bloopses:   ruBloopses,
+
+	NounPoints:  []string{"очко", "очка", "очков"},
+	NounSeconds: []string{"секунда", "секунды", "секунд"},
+	NounWords:   []string{"слово", "слова", "слов"},
+
+	// common menu button text
+	CreateButtonText:      emoji.Fire.String() + " Создать игру",
+	LeaveButtonText:       emoji.ChequeredFlag.String() + " Выйти",
+	StartButtonText:       emoji.Rocket.String() + " Начать",
+	JoinButtonText:        emoji.VideoGame.String() + " Присоединиться к игре",
+	RatingButtonText:      emoji.Star.String() + " Таблица лидеров",
+	RuleButtonText:        "Правила",
+	GameSettingButtonText: "Параметры игы",
+	ProfileButtonText:     emoji.Alien.String() + " Профиль",
+	WatchButtonText:       emoji.Eyes.String() + " Смотреть игру",
+	ManageButtonText:      emoji.HammerAndWrench.String() + " Управление игрой",
+	FindButtonText:        emoji.MagnifyingGlassTiltedLeft.String() + " Найти игру",
+
+	// builder inline button text
+	BuilderInlineNextText: "Далее",
+	BuilderInlinePrevText: "Назад",
+	BuilderInlineDoneText: emoji.ChequeredFlag.String() + " Завершить",
+
+	// manage text messages
+	TextAuthorGreetingMsg: "\n\nТы - ведущий игрок " + emoji.FlexedBiceps.String() + "\n\n" +
+		"Когда все игроки присоединятся тебе нужно нажать\n" + emoji.Rocket.String() + " *Начать* " + " для старта",
+	TextJoinedGameMsg:                "Ты присоединился к игре! ",
+	TextWatchingGameMsg:              "Ты смотришь игру, тебе будут видны её ход и таблица лидеров ",
+	TextFeedbackMsg:                  "Ты можешь отправить анонимный отзыв",
+	TextFeedbackReceivedMsg:          "Прилетел фидбек от пользователя: %s",
+	TextBanMsg:                       "Отправь username пользователя",
+	TextBannedMsg:                    "Бан",
+	TextBanUserNotFoundMsg:           "Пользователь не найден: %s",
+	TextBanAdminMsg:                  "Нельзя забанить администратора",
+	TextUserBannedMsg:                "Пользователь забанен: %s",
+	TextAdminRequiredMsg:             "Для этой команды нужны права администратора",
+	TextGameRoomNotFoundMsg:          "Игровая комната не найдена",
+	TextInviteMsg:                    "Отсканируй QR-код или поделись ссылкой, чтобы присоединиться к игре: %s",
+	TextSendJoinedCodeMsg:            "Отправь код подключения к игре",
+	TextLeavingSessionsMsg:           "Ты покинул все игровые сеансы",
+	TextSendOfflinePlayerUsernameMsg: "Отправь имя оффлайн пользователя",
+	TextSendProfileMsg:               "Отправь @username пользователя",
+	TextBuilderWarnMsg: emoji.BrokenHeart.String() + " К сожалению " + emoji.Robot.String() +
+		" бот обновляется, настройка игры продолжится через несколько минут",
+	TextMatchWarnMsg: emoji.BrokenHeart.String() + " К сожалению " + emoji.Robot.String() +
+		" бот обновляется, этот раунд начнется заново через несколько секунд!",
+	TextProfileCmdUserNotFound: "Пользователь не найден",
+	TextGameRoomNotFound:       "Тебе нужно присоединиться к игре, чтобы добавлять оффлайн игроков",
+	TextOfflinePlayerAdded:     "Оффлайн игрок добавлен. Все сообщения будут приходить тебе",
+	TextCreationGameCompletedSuccessfulMsg: emoji.Unicorn.String() + " Игровая комната создана.\n\nДля входа нужно " +
+		"нажать кнопку " + emoji.VideoGame.String() + " *Присоединится к игре* и ввести этот код.\n\n" +
+		emoji.PartyingFace.String() + " Отправь код тем, с кем собираешься играть",
+
+	TextSettingsMsg: emoji.Gear.String() + " Настраиваем параметры игры",
+
+	TextGreetingMsg: emoji.ChristmasTree.String() + emoji.ChristmasTree.String() + emoji.ChristmasTree.String() + "Привет, %s\n\n" +
+		"Это " + `@blooops\_bot` + emoji.Robot.String() + " - бот, для игры в небольшие викторины, где участники должны за " + emoji.Stopwatch.String() + " 30 сек " +
+		"назвать по одному слову из нескольких категорий, начинающихся на выпавшую букву\n\n" +
+		"Бот" + emoji.Robot.String() + " предназначен для ведения игр в оффлайн" +
+		" Он подсчитывает очки, генерирует буквы, создает лидерборды, и задает правила, а вы играете!" + emoji.Unicorn.String() + "\n\n" +
+		"*Правила:* " + CmdRules + "\n\n" +
+		"*Обратная связь:* @robotomize\n" +
+		"*Проект на github:* [bloops_bot](https://github.com/robotomize/bloopsbot)",
+
+	TextRulesMsg: emoji.Bookmark.String() + " *Правила игры*\n\n" +
+		"Участники должны за " + emoji.Stopwatch.String() + " 30 сек " +
+		"назвать по одному слову из нескольких категорий, начинающихся на выпавшую букву\n" +
+		"По итогам нескольких раундов побеждают игроки с наибольшим количеством очков" + emoji.Trophy.String() + "\n\n" +
+		emoji.CrossMark.String() + " *Ограничения* - от 2х человек, " + `@bloopsbot\_bot ` + emoji.Robot.String() + " предназначен для ведения игр в оффлайн\n\n" +
+		emoji.Joystick.String() + " *Что делать?* - \nдля начала ведущий игрок должен " + emoji.Fire.String() + " *Создать игру* и выполнить действия по настройке." +
+		" Ему будет выслан код, который он сообщает участникам. Затем игроки " +
+		"присоединяются к игре и ведуший нажимает кнопку \n" + emoji.Rocket.String() + " *Начать*\n\n" +
+		emoji.Loudspeaker.String() + " *Голосование* - после каждого раунда игроки определяют справился ли участник с заданием, если решили, что нет, то игрок не получает заработанные в раунде очки\n\n" +
+		emoji.GemStone.String() + " *Блюпсы* - это дополнительные задания, " +
+		"которые нужно выполнять параллельно с основным процессом игры, они выпадают игроку с некоторым шансом \n\n" +
+		"*Список команд:* \n" +
+		"/start - устанавливает бот и отправляет краткую справку по проекту\n" +
+		"/rules - отправляет набор правил игры\n" +
+		"/feedback - отправить анонимный отзыв\n" +
+		"/profile - позволяет посмотреть профиль другого игрока\n" +
+		"/top - лучшие игроки месяца и за все время\n" +
+		"/add - если ты зашел в игровую команту, то можешь добавить игроков у которых нет телеграмма, так называемых виртуальных игроков, их задания будут приходить тебе. Ты можешь дать им свой смартфон, когда подойдет их очередь играть\n\n" +
+		"*Игра в группе:* \n" +
+		"/game - создать игру в групповом чате, таймер, буквы и результаты будут приходить в группу\n" +
+		"/join - присоединиться к игре в групповом чате\n" +
+		"/play - начать игру, доступно только автору\n" +
+		"/manage - исключить игрока, пропустить ход, поставить паузу, изменить очки или завершить игру, доступно только автору\n" +
+		"/leave - выйти из игры в групповом чате\n\n" +
+		"*Обратная связь:* @robotomize\n" +
+		"*Проект на github:* [bloops_bot](https://github.com/robotomize/bloopsbot)",
+	TextChatNotAllowed: emoji.WomanGesturingNo.String() + " Эта команда работает только в личном чате с ботом",
+
+	// profile
+	TextProfileTitle:       "%s Профиль игрока *%s*\n\n",
+	TextProfileGames:       "%s Сыграно: %s\n",
+	TextProfileStars:       "%s Побед: %s\n",
+	TextProfileRating:      "%s Рейтинг: %d ± %d (%s)\n",
+	TextProfileNotRated:    "%s Рейтинг: пока нет\n",
+	TextProfileBloops:      "%s Блюпсов открыто: %s/%s\n",
+	TextProfileBestTime:    "%s Лучшее время раунда: %s\n",
+	TextProfileAverageTime: "%s Среднее время раунда: %s\n",
+	TextProfileBestPoints:  "%s Лучший счет раунда: %s",
+
+	// group chat text messages
+	TextGroupGameCreatedMsg: emoji.Unicorn.String() + " Игровая комната создана в этом чате.\n\n" +
+		"Чтобы присоединиться, отправь " + CmdGroupJoin + ", чтобы смотреть - " + CmdGroupWatch + ", автор начинает игру командой " + CmdGroupStart,
+	TextGroupGameExistsMsg:   "В этом чате уже создается или идет игра",
+	TextGroupGameNotFoundMsg: "В этом чате нет игры, создай ее командой " + CmdGroupGame,
+	TextGroupPlayerJoinedMsg: "%s присоединился к игре",
+	TextGroupSpectatorMsg:    "%s смотрит игру",
+	TextGroupPlayerInGameMsg: "%s, ты уже участвуешь в другой игре",
+	TextNotYourTurnMsg:       "Сейчас не твой ход",
+
+	// builder text messages
+	TextChooseLocale:                "Выбери язык игры",
+	TextChooseCategories:            "Выбери категории или напиши свою",
+	TextChooseRoundsNum:             "Выбери количество раундов(по умолчанию 1)",
+	TextDeleteComplexLetters:        "Убери сложные буквы",
+	TextVoteAllowed:                 emoji.Loudspeaker.String() + " Добавить голосование?\n\nПодробнее: /rules",
+	TextBloopsAllowed:               emoji.GemStone.String() + " Добавить блюпсы?\n\nПодробнее: /rules",
+	TextSpectatorsVoteAllowed:       emoji.Eyes.String() + " Могут ли зрители голосовать?",
+	TextOfflineVoteAllowed:          emoji.MobilePhone.String() + " Голосуют ли офлайн-игроки? Их ведущий получит отдельное голосование за каждого из них",
+	TextTypedAnswersAllowed:         emoji.Keyboard.String() + " Писать ответы в чат?\n\nИгрок пишет слова, пока идет таймер, бот проверяет букву и повторы, каждое принятое слово дает %d очка",
+	TextConfigurationDone:           "Завершить процесс создания игры?",
+	TextAddLeastCategoryToComplete:  "Необходимо добавить больше категорий",
+	TextAddLeastOneLetterToComplete: "Добавьте хотя бы одну букву для завершения",
+	TextAddedCategory:               "Добавлена категория %s",
+	TextDeletedCategory:             "Удалена категория %s",
+	TextRoundsNumAnswer:             "Количество раундов - %d",
+	TextAddedLetter:                 "Добавлена буква %s",
+	TextDeletedLetter:               "Удалена буква %s",
+	TextVoteYes:                     emoji.ThumbsUp.String() + " Да",
+	TextVoteNo:                      emoji.ThumbsDown.String() + " Нет",
+
+	// builder pack text messages
+	TextChoosePack: emoji.Package.String() + " Выбери один из своих наборов категорий и букв или отправь код чужого набора\n\n" +
+		"Нажми Далее, чтобы оставить стандартные",
+	TextPackChosenAnswer:   "Выбран набор %s",
+	TextPackNotFoundMsg:    "Набор с кодом %s не найден",
+	TextSavePackBtn:        emoji.FloppyDisk.String() + " Сохранить как набор",
+	TextSendPackNameMsg:    "Отправь название набора",
+	TextPackNameInvalidMsg: "Название набора должно быть от 1 до %d символов",
+	TextPackSavedMsg:       emoji.Package.String() + " Набор \"%s\" сохранен\n\nПоделись кодом %d с друзьями, чтобы они могли играть с ним",
+
+	// builder bloopses text messages
+	TextChooseBloopses: emoji.GemStone.String() + " Выбери блупсы для игры или добавь свои, отправь сообщение:\n\n" +
+		"название; задание; очки; секунды; вес\n\n" +
+		"Очки от %d до %d, секунды от %d до %d, вес от %d до %d, секунды и вес можно не указывать",
+	TextAddedBloops:             "Блупс %s добавлен",
+	TextDeletedBloops:           "Блупс %s удален",
+	TextBloopsFormatInvalidMsg:  "Отправь блупс в формате: название; задание; очки; секунды; вес",
+	TextBloopsPointsInvalidMsg:  "Очки блупса должны быть от %d до %d",
+	TextBloopsSecondsInvalidMsg: "Секунды блупса должны быть от %d до %d",
+	TextBloopsWeightInvalidMsg:  "Вес блупса должен быть от %d до %d",
+	TextChooseBloopsChance: emoji.GameDie.String() + " Выбери шанс выпадения блупса перед ходом " +
+		"и может ли один и тот же блупс выпасть снова",
+	TextBloopsChanceAnswer: "Шанс - %d%%",
+	TextBloopsRepeatOn:     emoji.RepeatButton.String() + " Блупсы повторяются",
+	TextBloopsRepeatOff:    emoji.RepeatSingleButton.String() + " Блупсы не повторяются",
+	TextChooseVoteRule: emoji.BalanceScale.String() + " Выбери, когда игрок теряет очки раунда " +
+		"и сколько времени длится голосование",
+	TextVoteRuleMajority:  "Большинство голосов против",
+	TextVoteRuleTwoThirds: "Две трети голосов против",
+	TextVoteRuleUnanimous: "Все голоса против",
+	TextVoteRulePartial:   "Очки по доле голосов за",
+	TextVoteTimeoutAnswer: "Время голосования - %d сек",
+
+	// team text messages
+	TextChooseTeams:       emoji.Family.String() + " Играть командами? Выбери количество команд или напиши названия команд по одному в сообщении",
+	TextNoTeams:           "Без команд",
+	TextTeamName:          "Команда %d",
+	TextTeamMsg:           "Ты играешь за команду *%s*, до начала игры ее можно сменить",
+	TextTeamChosen:        "Твоя команда: %s",
+	TextTeamNotChangeable: "Игра уже началась",
+	TextTeamMember:        "    %s, %d %s, %d/%d\n",
+	TextSettingsTeams:     "%s Команды: ",
+
+	// moderation text messages
+	TextManageMsg:          emoji.HammerAndWrench.String() + " Управление игрой, игроки видят каждое действие",
+	TextManageNotAllowed:   "Управлять игрой может только ведущий",
+	TextManageKick:         emoji.NoEntry.String() + " Исключить игрока",
+	TextManageSkip:         emoji.NextTrackButton.String() + " Пропустить ход",
+	TextManagePause:        emoji.PauseButton.String() + " Пауза",
+	TextManageResume:       emoji.PlayButton.String() + " Продолжить",
+	TextManagePoints:       emoji.Pencil.String() + " Изменить последние очки",
+	TextManageEnd:          emoji.ChequeredFlag.String() + " Завершить игру",
+	TextChoosePlayerMsg:    "Выбери игрока, которого нужно исключить",
+	TextPlayerKickedMsg:    emoji.NoEntry.String() + " Ведущий исключил %s из игры",
+	TextKickedMsg:          "Ведущий исключил тебя из игры",
+	TextTurnSkippedMsg:     emoji.NextTrackButton.String() + " Ведущий пропустил ход %s",
+	TextNoActiveTurnMsg:    "Сейчас никто не ходит",
+	TextGamePausedMsg:      emoji.PauseButton.String() + " Ведущий поставил игру на паузу, таймеры остановлены",
+	TextGameUnpausedMsg:    emoji.PlayButton.String() + " Ведущий продолжил игру",
+	TextSendPointsMsg:      "Отправь новые очки %s за последний ход, сейчас %d",
+	TextPointsInvalidMsg:   "Очки должны быть числом от 0 до %d",
+	TextPointsChangedMsg:   emoji.Pencil.String() + " Ведущий изменил очки %s за последний ход: %d → %d",
+	TextNoLastTurnMsg:      "Еще никто не сходил",
+	TextGameEndedByHostMsg: emoji.ChequeredFlag.String() + " Ведущий завершил игру",
+	TextTimerPaused:        "пауза",
+
+	// private game text messages
+	TextChoosePrivate:      emoji.Locked.String() + " Закрытая игра? Ты одобряешь каждый запрос на вход. Напиши PIN, чтобы его спрашивали у игроков",
+	TextPIN:                "PIN: %s",
+	TextSettingsPrivate:    "%s Закрытая игра: ",
+	TextSendPINMsg:         emoji.Key.String() + " Отправь PIN игры",
+	TextWrongPINMsg:        emoji.CrossMark.String() + " Неверный PIN",
+	TextJoinRequestSentMsg: emoji.HourglassNotDone.String() + " Запрос отправлен автору игры, дождись ответа",
+	TextJoinRequestMsg:     emoji.RaisingHands.String() + " *%s* просится в игру",
+	TextWatchRequestMsg:    emoji.Eyes.String() + " *%s* хочет посмотреть игру",
+	TextJoinApprove:        emoji.CheckMarkButton.String() + " Впустить",
+	TextJoinDeny:           emoji.CrossMark.String() + " Отказать",
+	TextJoinApprovedMsg:    emoji.CheckMarkButton.String() + " *%s* в игре",
+	TextJoinDeniedMsg:      emoji.NoEntry.String() + " Автор игры отклонил твой запрос",
+	TextJoinRequestDenied:  emoji.CrossMark.String() + " *%s* получил отказ",
+	TextJoinLockedMsg:      emoji.NoEntry.String() + " Слишком много попыток входа, попробуй через %d мин",
+
+	// lobby text messages
+	TextChoosePublic:      emoji.GlobeWithMeridians.String() + " Открытая игра? Её смогут найти в списке игр. Выбери, сколько игроков максимум",
+	TextMaxPlayers:        "%d игроков",
+	TextSettingsPublic:    "%s Открытая игра, до %d игроков",
+	TextLobbyTitle:        emoji.GlobeWithMeridians.String() + " *Открытые игры*\n\n",
+	TextLobbyEntry:        "*#%d* · %s %s · %s %d мин\n",
+	TextLobbyEmptyMsg:     emoji.GlobeWithMeridians.String() + " Сейчас нет открытых игр, которые ждут игроков. Попробуй быструю игру!",
+	TextLobbyJoin:         "Войти в #%d",
+	TextLobbyGoneMsg:      "Игра уже началась",
+	TextGameFullMsg:       emoji.NoEntry.String() + " В игре нет мест",
+	TextQuickMatch:        emoji.GameDie.String() + " Быстрая игра",
+	TextQueueJoinedMsg:    emoji.GameDie.String() + " Ищем игроков: ждут %d из %d. Нажми быструю игру ещё раз, чтобы перестать искать",
+	TextQueueLeftMsg:      "Ты больше не ищешь игроков",
+	TextMatchFoundMsg:     emoji.GameDie.String() + " Игроки найдены! Игру начинает *%s*",
+	TextMatchFoundHostMsg: emoji.GameDie.String() + " Игроки найдены! Ты ведущий, нажми старт, когда все будут готовы",
+
+	// leaderboard text messages
+	TextTopTitle:    "%s *%s* · %s\n\n",
+	TextTopEntry:    "%s *%s* - %s\n",
+	TextTopEmptyMsg: "В таблице лидеров пока никого нет, сыграй, чтобы стать первым!\n",
+	TextTopPosition: "\nТвое место: *%d* - %s",
+	TextTopStars:    "Победы",
+	TextTopPoints:   "Очки",
+	TextTopTime:     "Лучшее время раунда",
+	TextTopBloops:   "Блюпсы",
+	TextTopMonth:    "Этот месяц",
+	TextTopAllTime:  "Все время",
+
+	// match text messages
+	TextLeaderboardHeader:   "*Результаты игры*\n\n",
+	TextRoundFavoriteMsg:    emoji.ChequeredFlag.String() + " Раунд %d завершен",
+	TextClickStartBtnMsg:    emoji.ChequeredFlag.String() + " Нажми кнопку, когда будешь готов",
+	TextStartBtn:            "Я готов!",
+	TextStopBtn:             "Стоп",
+	TextStartBtnDataAnswer:  "Старт!",
+	TextChallengeBtn:        "Понятно",
+	TextStopBtnDataAnswer:   "Стоп!",
+	TextStartLetterMsg:      "Слова на букву - ",
+	TextNextPlayerMsg:       "*%s* - твоя очередь",
+	TextHostedTurnMsg:       emoji.MobilePhone.String() + " Ходит *%s*, передай телефон",
+	TextOfflineVoteMsg:      "Голосует %s\n\n",
+	TextPlayerLeftGameMsg:   "Игрок %s покинул игру",
+	TextPlayerJoinedGameMsg: "Игрок %s присоединился к игре",
+	TextSpectatorJoinedMsg:  "%s смотрит игру",
+	TextStopPlayerRoundMsg:  "Завершено! Ты набрал %d %s!",
+	TextGameStarted:         "Игра началась!",
+	TextVoteMsg:             "Голосование, игрок всё правильно назвал?",
+	TextVoteCancelledMsg:    "Игрок не успел справиться с заданием, голосование отменено",
+	TextVoteKnownMsg:        "Все слова есть в словаре, голосование не нужно",
+	TextVoteAnswer:          "Твой голос: %s",
+	TextVoteNotAllowed:      "Ты не можешь голосовать в этом раунде",
+	TextVoteAnswersHint:     "Нажми на неверное слово, чтобы отклонить его, остальные слова сохранят очки",
+	TextAnswerRejected:      "Отклонено: %s",
+	TextAnswerRestored:      "Возвращено: %s",
+	TextTypeAnswersMsg:      emoji.Keyboard.String() + " Пиши слова по одному в строке, они заполняют категории по порядку, начни строку с номера категории, чтобы ответить на другую",
+	TextAnswerAcceptedMsg:   emoji.CheckMarkButton.String() + " %s: %s",
+	TextAnswerLetterMsg:     emoji.CrossMark.String() + " %s начинается не с %s",
+	TextAnswerDuplicateMsg:  emoji.CrossMark.String() + " %s уже называли в этой игре",
+	TextBroadcastCrashMsg:   "Из-за ошибки в работе сервиса игра была аварийно завершена, попробуйте создать игру заново",
+	TextGameResumedMsg:      emoji.PlayButton.String() + " Игра продолжается после перезапуска бота",
+	TextStopButton:          "Нажми Стоп, когда закончишь",
+	TextCheckBloopsMsg:      "Проверяем, выпадет ли блюпс?",
+	TextBloopsNotDroppedMsg: emoji.GameDie.String() + " Блюпс не выпал",
+	TextChallengeWarnMsg:    "Игрок %s должен нажать на кнопку Понятно в течение %d сек",
+	TextStartWarnMsg:        "Игрок %s должен нажать на кнопку старта в течение %d сек",
+	TextSkipTurnMsg:         "%s не начал раунд в течение %d сек, он пропускает ход",
+	TextReadyMsg:            "На старт",
+	TextSteadyMsg:           "Внимание",
+	TextGoMsg:               "Марш!",
+	TextRegularRound:        "Обычный раунд",
+	TextUnknownCard:         emoji.GemStone.String() + " Неизвестная карта",
+	TextChooseCardMsg:       "Выбери карту, тебе может попасться блюпс",
+	TextCardFoundAnswer:     "Нашел!",
+	TextCardEmptyAnswer:     "Тут ничего!",
+	TextSecondsShort:        "сек",
+	TextYes:                 "да",
+	TextNo:                  "нет",
+
+	// match render
+	TextDropBloopsTitle:    "%s *БЛЮПС!*\n\n",
+	TextDropBloopsFooter:   "\n\nРасскажи о блюпсе игрокам и постарайся выполнить",
+	TextStartRoundTitle:    "%s Готов сыграть?\n\n",
+	TextStartRoundTask:     "Нужно назвать все слова из списка категорий на выпавшую букву\n\n",
+	TextCategoriesTitle:    "%s Категории:\n\n",
+	TextGameFinishedTitle:  "%s Игра завершена\n\n*Список победителей*\n\n",
+	TextSettingsTitle:      "%s *Параметры*\n\n",
+	TextSettingsRoundsNum:  "%s  Количество раундов: %s\n",
+	TextSettingsRoundTime:  "%s Время раунда: %s сек\n",
+	TextSettingsBloops:     "%s Блюпсы: ",
+	TextSettingsVote:       "%s Голосование: ",
+	TextSettingsSpectators: ", зрители голосуют",
+	TextSettingsOffline:    ", офлайн-игроки голосуют",
+	TextSettingsAnswers:    "%s Ответы в чат: ",
+	TextSettingsCategories: "%s Категории\n",
+	TextPlayerGetPoints:    "%s набирает %d %s",
+	TextStartHelpMsg:       "Игрок  %s должен назвать слова:\n\n",
+	TextStartHelpLetter:    "На букву: *%s*",
+}
@@ -0,0 +1,125 @@
+package bloopsbot
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+	"github.com/bloops-games/bloops/internal/bloopsbot/transport"
+	leaderboardDb "github.com/bloops-games/bloops/internal/database/leaderboard/database"
+	leaderboardModel "github.com/bloops-games/bloops/internal/database/leaderboard/model"
+	userModel "github.com/bloops-games/bloops/internal/database/user/model"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+// top:<metric>:<season>
+const topPrefix = "top:"
+
+// number of the users shown on the leaderboard
+const topSize = 10
+
+// handleTopCommand sends the winners of the current month
+func (m *manager) handleTopCommand(u userModel.User, chatID int64) error {
+	lang := resource.Lang(u.LanguageCode)
+	season := leaderboardModel.Season(time.Now())
+	text, markup, err := m.renderTopMsg(lang, u.ID, leaderboardModel.MetricStars, season)
+	if err != nil {
+		return fmt.Errorf("render top: %w", err)
+	}
+
+	msg := transport.Message{ChatID: chatID, Text: text, Markdown: true, Keyboard: markup}
+	if _, err := m.tg.SendText(msg); err != nil {
+		return fmt.Errorf("send msg: %w", err)
+	}
+
+	return nil
+}
+
+// handleTopCallback switches the leaderboard shown in the message to another metric or season
+func (m *manager) handleTopCallback(u userModel.User, query *tgbotapi.CallbackQuery) error {
+	if err := m.tg.AnswerCallback(query.ID, ""); err != nil {
+		return fmt.Errorf("send answer msg: %w", err)
+	}
+
+	if query.Message == nil {
+		return nil
+	}
+
+	parts := strings.SplitN(strings.TrimPrefix(query.Data, topPrefix), ":", 2)
+	if len(parts) != 2 {
+		return nil
+	}
+
+	lang := resource.Lang(u.LanguageCode)
+	text, markup, err := m.renderTopMsg(lang, u.ID, leaderboardModel.Metric(parts[0]), parts[1])
+	if err != nil {
+		return fmt.Errorf("render top: %w", err)
+	}
+
+	msg := transport.Message{
+		ChatID:    query.Message.Chat.ID,
+		MessageID: query.Message.MessageID,
+		Text:      text,
+		Markdown:  true,
+		Keyboard:  markup,
+	}
+	if err := m.tg.EditText(msg); err != nil {
+		return fmt.Errorf("edit msg: %w", err)
+	}
+
+	return nil
+}
+
+// renderTopMsg renders the leaderboard and the buttons to switch between the metrics and the seasons
+func (m *manager) renderTopMsg(
+	lang *resource.Catalog,
+	userID int64,
+	metric leaderboardModel.Metric,
+	season string,
+) (string, tgbotapi.InlineKeyboardMarkup, error) {
+	var markup tgbotapi.InlineKeyboardMarkup
+	entries, err := m.leaderboardDB.Top(season, metric, topSize)
+	if err != nil && !errors.Is(err, leaderboardDb.ErrNotFound) {
+		return "", markup, fmt.Errorf("leaderboard db top: %w", err)
+	}
+
+	var own *leaderboardModel.Entry
+	entry, err := m.leaderboardDB.Position(season, metric, userID)
+	if err != nil {
+		if !errors.Is(err, leaderboardDb.ErrNotFound) {
+			return "", markup, fmt.Errorf("leaderboard db position: %w", err)
+		}
+	} else if entry.Rank > topSize {
+		own = &entry
+	}
+
+	metrics := tgbotapi.NewInlineKeyboardRow()
+	for _, mt := range leaderboardModel.Metrics {
+		name := topMetricName(lang, mt)
+		if mt == metric {
+			name = "· " + name + " ·"
+		}
+
+		metrics = append(metrics, tgbotapi.NewInlineKeyboardButtonData(name, topPrefix+string(mt)+":"+season))
+	}
+
+	seasons := tgbotapi.NewInlineKeyboardRow()
+	for _, s := range []string{leaderboardModel.Season(time.Now()), leaderboardModel.SeasonAllTime} {
+		name := lang.TextTopMonth
+		if s == leaderboardModel.SeasonAllTime {
+			name = lang.TextTopAllTime
+		}
+
+		if s == season {
+			name = "· " + name + " ·"
+		}
+
+		seasons = append(seasons, tgbotapi.NewInlineKeyboardButtonData(name, topPrefix+string(metric)+":"+s))
+	}
+
+	markup = tgbotapi.NewInlineKeyboardMarkup(metrics[:2], metrics[2:], seasons)
+
+	return renderTop(lang, metric, season, entries, own), markup, nil
+}
@@ -0,0 +1,166 @@
+package transport
+
+import (
+	"context"
+	"sync"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+var (
+	_ Transport = (*Recorder)(nil)
+	_ Updater   = (*Recorder)(nil)
+)
+
+type RecordKind uint8
+
+const (
+	RecordKindText RecordKind = iota + 1
+	RecordKindEditText
+	RecordKindEditKeyboard
+	RecordKindDelete
+	RecordKindSticker
+	RecordKindCallback
+	RecordKindPhoto
+)
+
+// Record is a single call of the transport
+type Record struct {
+	Kind       RecordKind
+	ChatID     int64
+	MessageID  int
+	Text       string
+	Markdown   bool
+	Keyboard   interface{}
+	FileID     string
+	CallbackID string
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{updates: make(chan tgbotapi.Update, 100)}
+}
+
+// Recorder is an in-memory transport, it stores every call and assigns sequential message ids
+type Recorder struct {
+	mtx     sync.RWMutex
+	records []Record
+	seq     int
+	updates chan tgbotapi.Update
+}
+
+func (r *Recorder) SendText(msg Message) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.seq++
+	r.records = append(r.records, Record{
+		Kind:      RecordKindText,
+		ChatID:    msg.ChatID,
+		MessageID: r.seq,
+		Text:      msg.Text,
+		Markdown:  msg.Markdown,
+		Keyboard:  msg.Keyboard,
+	})
+
+	return r.seq, nil
+}
+
+func (r *Recorder) EditText(msg Message) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.records = append(r.records, Record{
+		Kind:      RecordKindEditText,
+		ChatID:    msg.ChatID,
+		MessageID: msg.MessageID,
+		Text:      msg.Text,
+		Markdown:  msg.Markdown,
+		Keyboard:  msg.Keyboard,
+	})
+
+	return nil
+}
+
+func (r *Recorder) EditKeyboard(chatID int64, messageID int, keyboard tgbotapi.InlineKeyboardMarkup) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.records = append(r.records, Record{
+		Kind:      RecordKindEditKeyboard,
+		ChatID:    chatID,
+		MessageID: messageID,
+		Keyboard:  keyboard,
+	})
+
+	return nil
+}
+
+func (r *Recorder) DeleteMessage(chatID int64, messageID int) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.records = append(r.records, Record{Kind: RecordKindDelete, ChatID: chatID, MessageID: messageID})
+
+	return nil
+}
+
+func (r *Recorder) SendSticker(chatID int64, fileID string) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.seq++
+	r.records = append(r.records, Record{Kind: RecordKindSticker, ChatID: chatID, MessageID: r.seq, FileID: fileID})
+
+	return r.seq, nil
+}
+
+func (r *Recorder) SendPhoto(chatID int64, name string, _ []byte, caption string) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.seq++
+	r.records = append(r.records, Record{Kind: RecordKindPhoto, ChatID: chatID, MessageID: r.seq, FileID: name, Text: caption})
+
+	return r.seq, nil
+}
+
+func (r *Recorder) AnswerCallback(callbackID, text string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.records = append(r.records, Record{Kind: RecordKindCallback, CallbackID: callbackID, Text: text})
+
+	return nil
+}
+
+func (r *Recorder) Updates(_ context.Context) (tgbotapi.UpdatesChannel, error) {
+	return r.updates, nil
+}
+
+// Push emulates an incoming update
+func (r *Recorder) Push(upd tgbotapi.Update) {
+	r.updates <- upd
+}
+
+// Records returns a copy of all recorded calls
+func (r *Recorder) Records() []Record {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	records := make([]Record, len(r.records))
+	copy(records, r.records)
+
+	return records
+}
+
+// Find returns recorded calls of the kind sent to the chat
+func (r *Recorder) Find(kind RecordKind, chatID int64) []Record {
+	r.mtx.RLock()
+	defer r.mtx.RUnlock()
+	var records []Record
+	for _, record := range r.records {
+		if record.Kind == kind && record.ChatID == chatID {
+			records = append(records, record)
+		}
+	}
+
+	return records
+}
+
+func (r *Recorder) Reset() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.records = nil
+}
@@ -0,0 +1,169 @@
+package transport
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/logging"
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+var (
+	_ Transport = (*Telegram)(nil)
+	_ Updater   = (*Telegram)(nil)
+)
+
+type TelegramConfig struct {
+	Token       string
+	WebhookURL  string
+	WebhookAddr string
+	PollTimeout time.Duration
+}
+
+func NewTelegram(bot *tgbotapi.BotAPI, config TelegramConfig) *Telegram {
+	return &Telegram{bot: bot, config: config}
+}
+
+// Telegram is the transport on top of the telegram bot api
+type Telegram struct {
+	bot    *tgbotapi.BotAPI
+	config TelegramConfig
+}
+
+func (t *Telegram) SendText(msg Message) (int, error) {
+	cfg := tgbotapi.NewMessage(msg.ChatID, msg.Text)
+	if msg.Markdown {
+		cfg.ParseMode = tgbotapi.ModeMarkdown
+	}
+	cfg.ReplyMarkup = msg.Keyboard
+
+	output, err := t.bot.Send(cfg)
+	if err != nil {
+		return 0, fmt.Errorf("send: %w", err)
+	}
+
+	return output.MessageID, nil
+}
+
+func (t *Telegram) EditText(msg Message) error {
+	cfg := tgbotapi.NewEditMessageText(msg.ChatID, msg.MessageID, msg.Text)
+	if msg.Markdown {
+		cfg.ParseMode = tgbotapi.ModeMarkdown
+	}
+
+	if keyboard, ok := msg.Keyboard.(tgbotapi.InlineKeyboardMarkup); ok {
+		cfg.ReplyMarkup = &keyboard
+	}
+
+	if _, err := t.bot.Send(cfg); err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	return nil
+}
+
+func (t *Telegram) EditKeyboard(chatID int64, messageID int, keyboard tgbotapi.InlineKeyboardMarkup) error {
+	if _, err := t.bot.Send(tgbotapi.NewEditMessageReplyMarkup(chatID, messageID, keyboard)); err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	return nil
+}
+
+func (t *Telegram) DeleteMessage(chatID int64, messageID int) error {
+	if _, err := t.bot.Send(tgbotapi.NewDeleteMessage(chatID, messageID)); err != nil {
+		return fmt.Errorf("send: %w", err)
+	}
+
+	return nil
+}
+
+func (t *Telegram) SendSticker(chatID int64, fileID string) (int, error) {
+	output, err := t.bot.Send(tgbotapi.NewStickerShare(chatID, fileID))
+	if err != nil {
+		return 0, fmt.Errorf("send: %w", err)
+	}
+
+	return output.MessageID, nil
+}
+
+func (t *Telegram) SendPhoto(chatID int64, name string, data []byte, caption string) (int, error) {
+	cfg := tgbotapi.NewPhotoUpload(chatID, tgbotapi.FileBytes{Name: name, Bytes: data})
+	cfg.Caption = caption
+
+	output, err := t.bot.Send(cfg)
+	if err != nil {
+		return 0, fmt.Errorf("send: %w", err)
+	}
+
+	return output.MessageID, nil
+}
+
+func (t *Telegram) AnswerCallback(callbackID, text string) error {
+	if _, err := t.bot.AnswerCallbackQuery(tgbotapi.NewCallback(callbackID, text)); err != nil {
+		return fmt.Errorf("answer callback query: %w", err)
+	}
+
+	return nil
+}
+
+// Updates registers the webhook if it is configured, otherwise starts long polling
+func (t *Telegram) Updates(ctx context.Context) (tgbotapi.UpdatesChannel, error) {
+	logger := logging.FromContext(ctx).Named("transport.Telegram.Updates")
+	if t.config.WebhookURL != "" {
+		if _, err := t.bot.SetWebhook(tgbotapi.NewWebhook(t.config.WebhookURL + t.config.Token)); err != nil {
+			return nil, fmt.Errorf("tg bot set webhook: %w", err)
+		}
+
+		info, err := t.bot.GetWebhookInfo()
+		if err != nil {
+			return nil, fmt.Errorf("get webhook info: %w", err)
+		}
+
+		if info.LastErrorDate != 0 {
+			logger.Errorf("Telegram callback failed: %s", info.LastErrorMessage)
+		}
+
+		updates := t.bot.ListenForWebhook("/" + t.config.Token)
+		go func() {
+			if err := http.ListenAndServe(t.config.WebhookAddr, nil); err != nil {
+				logger.Fatalf("listen and serve http stopped: %v", err)
+			}
+		}()
+
+		return updates, nil
+	}
+
+	resp, err := t.bot.RemoveWebhook()
+	if err != nil {
+		return nil, fmt.Errorf("remove webhook: %w", err)
+	}
+
+	if !resp.Ok {
+		if resp.ErrorCode > 0 {
+			return nil, fmt.Errorf(
+				"remove webhook with error code %d and description %s",
+				resp.ErrorCode,
+				resp.Description,
+			)
+		}
+		return nil, fmt.Errorf("remove webhook response not ok=)")
+	}
+
+	upd := tgbotapi.NewUpdate(0)
+	upd.Timeout = int(t.config.PollTimeout.Seconds())
+	updates, err := t.bot.GetUpdatesChan(upd)
+	if err != nil {
+		return nil, fmt.Errorf("tg get updates chan: %w", err)
+	}
+
+	// stop polling telegram once the manager stops
+	go func() {
+		<-ctx.Done()
+		t.bot.StopReceivingUpdates()
+	}()
+
+	return updates, nil
+}
@@ -0,0 +1,37 @@
+package transport
+
+import (
+	"context"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+)
+
+// Message is an outgoing text message. MessageID is used only when editing an already sent message
+type Message struct {
+	ChatID    int64
+	MessageID int
+	Text      string
+	Markdown  bool
+	// tgbotapi.InlineKeyboardMarkup, tgbotapi.ReplyKeyboardMarkup or tgbotapi.ReplyKeyboardRemove
+	Keyboard interface{}
+}
+
+// Transport delivers game output to the players
+type Transport interface {
+	// SendText sends a new message and returns its id
+	SendText(msg Message) (int, error)
+	// EditText replaces the text and the inline keyboard of the message
+	EditText(msg Message) error
+	// EditKeyboard replaces only the inline keyboard of the message
+	EditKeyboard(chatID int64, messageID int, keyboard tgbotapi.InlineKeyboardMarkup) error
+	DeleteMessage(chatID int64, messageID int) error
+	SendSticker(chatID int64, fileID string) (int, error)
+	// SendPhoto uploads the image with the caption and returns the message id
+	SendPhoto(chatID int64, name string, data []byte, caption string) (int, error)
+	AnswerCallback(callbackID, text string) error
+}
+
+// Updater is the source of incoming updates
+type Updater interface {
+	Updates(ctx context.Context) (tgbotapi.UpdatesChannel, error)
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const (
+	// InvitePrefix starts the payload of the deep link that joins the game
+	InvitePrefix = "join_"
+
+	inviteQRSize = 512
+)
+
+// InviteLink returns the deep link that opens the chat with the bot and joins the game
+func InviteLink(bot string, code int64) string {
+	return fmt.Sprintf("https://t.me/%s?start=%s%d", strings.TrimPrefix(bot, "@"), InvitePrefix, code)
+}
+
+// ParseInvite returns the game code from the payload of the /start command
+func ParseInvite(payload string) (int64, bool) {
+	payload = strings.TrimSpace(payload)
+	if !strings.HasPrefix(payload, InvitePrefix) {
+		return 0, false
+	}
+
+	code, err := strconv.ParseInt(strings.TrimPrefix(payload, InvitePrefix), 10, 64)
+	if err != nil || code < 0 {
+		return 0, false
+	}
+
+	return code, true
+}
+
+// InviteQR renders the link as a QR code PNG
+func InviteQR(link string) ([]byte, error) {
+	png, err := qrcode.Encode(link, qrcode.Medium, inviteQRSize)
+	if err != nil {
+		return nil, fmt.Errorf("qrcode encode: %w", err)
+	}
+
+	return png, nil
+}
@@ -0,0 +1,81 @@
+package util
+
+import (
+	"sync"
+	"time"
+)
+
+// entries of the limiter that trigger the removal of the expired ones
+const limiterSweepSize = 1024
+
+// Limiter locks the key out after the number of failed attempts within the lockout time
+type Limiter struct {
+	attempts int
+	lockout  time.Duration
+
+	mtx     sync.Mutex
+	entries map[int64]*limiterEntry
+}
+
+type limiterEntry struct {
+	failures    int
+	firstAt     time.Time
+	lockedUntil time.Time
+}
+
+// NewLimiter returns the limiter, the limiter never locks if the number of the attempts is not positive
+func NewLimiter(attempts int, lockout time.Duration) *Limiter {
+	return &Limiter{attempts: attempts, lockout: lockout, entries: map[int64]*limiterEntry{}}
+}
+
+// Locked returns the time left until the key is unlocked
+func (l *Limiter) Locked(key int64) (time.Duration, bool) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	entry, ok := l.entries[key]
+	if !ok {
+		return 0, false
+	}
+
+	left := time.Until(entry.lockedUntil)
+
+	return left, left > 0
+}
+
+// Fail counts the failed attempt and reports whether the key is locked out now
+func (l *Limiter) Fail(key int64) bool {
+	if l.attempts <= 0 {
+		return false
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+
+	now := time.Now()
+	if len(l.entries) >= limiterSweepSize {
+		for k, entry := range l.entries {
+			if l.expired(entry, now) {
+				delete(l.entries, k)
+			}
+		}
+	}
+
+	entry, ok := l.entries[key]
+	if !ok || l.expired(entry, now) {
+		entry = &limiterEntry{firstAt: now}
+		l.entries[key] = entry
+	}
+
+	entry.failures++
+	if entry.failures >= l.attempts {
+		entry.lockedUntil = now.Add(l.lockout)
+	}
+
+	return !entry.lockedUntil.IsZero()
+}
+
+// expired reports whether the failures of the entry are not counted anymore, must be called under the lock
+func (l *Limiter) expired(entry *limiterEntry, now time.Time) bool {
+	return now.Sub(entry.firstAt) > l.lockout && now.After(entry.lockedUntil)
+}
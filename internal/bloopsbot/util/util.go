@@ -1,8 +1,6 @@
 package util
 
 import (
-	"fmt"
-	"hash/fnv"
 	"math"
 	"time"
 )
@@ -13,33 +11,37 @@ func Sleep(t time.Duration) {
 	<-timer.C
 }
 
-func Noun(number int, one, two, five string) string {
-	n := int(math.Abs(float64(number)))
-	n %= 100
-	if n >= 5 && n <= 20 {
-		return five
-	}
-	n %= 10
-	if n == 1 {
-		return one
-	}
-	if n >= 2 && n <= 4 {
-		return two
+// Plural returns the form of the noun for the number according to the plural rules of the locale.
+// Russian uses three forms: one, few and many (1 очко, 2 очка, 5 очков), the other locales use one and other
+func Plural(locale string, number int, forms ...string) string {
+	if len(forms) == 0 {
+		return ""
 	}
-	return five
-}
 
-func GenerateCodeHash() (int64, error) {
-	h := fnv.New32a()
-	bytes, err := time.Now().MarshalBinary()
-	if err != nil {
-		return 0, fmt.Errorf("hash binary encode error: %w", err)
+	n := int(math.Abs(float64(number)))
+	switch locale {
+	case "ru":
+		if len(forms) < 3 {
+			break
+		}
+
+		n %= 100
+		if n >= 5 && n <= 20 {
+			return forms[2]
+		}
+		n %= 10
+		if n == 1 {
+			return forms[0]
+		}
+		if n >= 2 && n <= 4 {
+			return forms[1]
+		}
+		return forms[2]
 	}
 
-	_, err = h.Write(bytes)
-	if err != nil {
-		return 0, fmt.Errorf("hash write error: %w", err)
+	if n == 1 || len(forms) == 1 {
+		return forms[0]
 	}
 
-	return int64(h.Sum32() >> 20), nil
+	return forms[1]
 }
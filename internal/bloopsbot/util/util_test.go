@@ -0,0 +1,108 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPlural(t *testing.T) {
+	t.Parallel()
+
+	ru := []string{"очко", "очка", "очков"}
+	en := []string{"point", "points"}
+
+	testCases := []struct {
+		locale   string
+		number   int
+		forms    []string
+		expected string
+	}{
+		{locale: "ru", number: 1, forms: ru, expected: "очко"},
+		{locale: "ru", number: 3, forms: ru, expected: "очка"},
+		{locale: "ru", number: 5, forms: ru, expected: "очков"},
+		{locale: "ru", number: 11, forms: ru, expected: "очков"},
+		{locale: "ru", number: 21, forms: ru, expected: "очко"},
+		{locale: "ru", number: -22, forms: ru, expected: "очка"},
+		{locale: "ru", number: 0, forms: ru, expected: "очков"},
+		{locale: "en", number: 1, forms: en, expected: "point"},
+		{locale: "en", number: 0, forms: en, expected: "points"},
+		{locale: "en", number: 21, forms: en, expected: "points"},
+		{locale: "en", number: -1, forms: en, expected: "point"},
+	}
+
+	for _, tc := range testCases {
+		if got := Plural(tc.locale, tc.number, tc.forms...); got != tc.expected {
+			t.Errorf("Plural(%s, %d): expected %s, got %s", tc.locale, tc.number, tc.expected, got)
+		}
+	}
+}
+
+func TestInvite(t *testing.T) {
+	t.Parallel()
+
+	link := InviteLink("@bloops_bot", 1234)
+	if link != "https://t.me/bloops_bot?start=join_1234" {
+		t.Fatalf("unexpected invite link %s", link)
+	}
+
+	testCases := []struct {
+		payload string
+		code    int64
+		ok      bool
+	}{
+		{payload: "join_1234", code: 1234, ok: true},
+		{payload: " join_42 ", code: 42, ok: true},
+		{payload: "join_", ok: false},
+		{payload: "join_-1", ok: false},
+		{payload: "join_abc", ok: false},
+		{payload: "1234", ok: false},
+		{payload: "", ok: false},
+	}
+
+	for _, tc := range testCases {
+		code, ok := ParseInvite(tc.payload)
+		if ok != tc.ok || code != tc.code {
+			t.Errorf("ParseInvite(%q): expected %d %t, got %d %t", tc.payload, tc.code, tc.ok, code, ok)
+		}
+	}
+
+	png, err := InviteQR(link)
+	if err != nil {
+		t.Fatalf("invite qr: %v", err)
+	}
+
+	if len(png) < 8 || string(png[1:4]) != "PNG" {
+		t.Errorf("expected a png image")
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	t.Parallel()
+
+	l := NewLimiter(3, time.Hour)
+	for i := 0; i < 2; i++ {
+		if l.Fail(1) {
+			t.Fatalf("expected the key not to be locked after %d attempts", i+1)
+		}
+	}
+
+	if _, locked := l.Locked(1); locked {
+		t.Fatalf("expected the key not to be locked")
+	}
+
+	if !l.Fail(1) {
+		t.Fatalf("expected the key to be locked")
+	}
+
+	if left, locked := l.Locked(1); !locked || left <= 0 || left > time.Hour {
+		t.Fatalf("expected the key to be locked for an hour, got %s %t", left, locked)
+	}
+
+	if _, locked := l.Locked(2); locked {
+		t.Fatalf("expected the other key not to be locked")
+	}
+
+	if disabled := NewLimiter(0, time.Hour); disabled.Fail(1) {
+		t.Fatalf("expected the disabled limiter never to lock")
+	}
+}
@@ -0,0 +1,92 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bloops-games/bloops/internal/byteutil"
+	"github.com/bloops-games/bloops/internal/database"
+	"github.com/bloops-games/bloops/internal/database/bloops/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+var ErrNotFound = fmt.Errorf("not found")
+
+const bucket = "bloopses"
+
+func New(db *database.DB) *DB {
+	return &DB{sDB: db}
+}
+
+type DB struct {
+	sDB *database.DB
+}
+
+// Add stores a new custom bloops and returns it with the assigned id
+func (db *DB) Add(m model.Bloops) (model.Bloops, error) {
+	tx, err := db.sDB.DB.Begin(true)
+	if err != nil {
+		return m, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	defer tx.Rollback() // nolint
+
+	b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+	if err != nil {
+		return m, fmt.Errorf("can not create bucket: %w", err)
+	}
+
+	seq, err := b.NextSequence()
+	if err != nil {
+		return m, fmt.Errorf("next sequence: %w", err)
+	}
+
+	m.ID = int64(seq)
+
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		return m, fmt.Errorf("marshal: %w", err)
+	}
+
+	if err := b.Put(byteutil.EncodeInt64ToBytes(m.ID), bytes); err != nil {
+		return m, fmt.Errorf("put to bucket error: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return m, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return m, nil
+}
+
+// FetchByAuthor returns all custom bloopses created by the user
+func (db *DB) FetchByAuthor(authorID int64) ([]model.Bloops, error) {
+	var list []model.Bloops
+	if err := db.sDB.DB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+
+		if err := b.ForEach(func(k, v []byte) error {
+			var bloops model.Bloops
+			if err := json.Unmarshal(v, &bloops); err != nil {
+				return fmt.Errorf("json unmarshal error, %w", err)
+			}
+
+			if bloops.AuthorID == authorID {
+				list = append(list, bloops)
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("bucket for each: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return list, nil
+}
@@ -0,0 +1,16 @@
+package model
+
+import "time"
+
+// Bloops is a custom bloops challenge authored by a user
+type Bloops struct {
+	ID        int64     `json:"id"`
+	AuthorID  int64     `json:"authorId"`
+	Locale    string    `json:"locale"`
+	Name      string    `json:"name"`
+	Task      string    `json:"task"`
+	Points    int       `json:"points"`
+	Seconds   int       `json:"seconds"`
+	Weight    int       `json:"weight"`
+	CreatedAt time.Time `json:"createdAt"`
+}
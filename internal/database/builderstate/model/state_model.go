@@ -0,0 +1,45 @@
+package model
+
+import (
+	"time"
+
+	"github.com/bloops-games/bloops/internal/bloopsbot/resource"
+)
+
+type BloopsOption struct {
+	resource.Bloops
+	Status bool
+}
+
+// State is a game setting interrupted by the bot shutdown
+type State struct {
+	AuthorID     int64               `json:"authorId"`
+	AuthorName   string              `json:"authorName"`
+	ChatID       int64               `json:"chatId"`
+	Group        bool                `json:"group"`
+	Locale       string              `json:"locale"`
+	Stage        uint8               `json:"stage"`
+	Categories   []resource.Category `json:"categories"`
+	Letters      []resource.Letter   `json:"letters"`
+	RoundsNum    int                 `json:"roundsNum"`
+	RoundTime    int                 `json:"roundTime"`
+	Vote         bool                `json:"vote"`
+	Bloops       bool                `json:"bloops"`
+	Bloopses     []BloopsOption      `json:"bloopses"`
+	BloopsChance int                 `json:"bloopsChance"`
+	BloopsRepeat bool                `json:"bloopsRepeat"`
+	PackCode     int64               `json:"packCode"`
+	VoteRule     resource.VoteRule   `json:"voteRule"`
+	VoteTimeout  int                 `json:"voteTimeout"`
+	TypedAnswers bool                `json:"typedAnswers"`
+
+	SpectatorsVote bool     `json:"spectatorsVote"`
+	Teams          []string `json:"teams"`
+	OfflineVote    bool     `json:"offlineVote"`
+	Private        bool     `json:"private"`
+	PIN            string   `json:"pin"`
+	Public         bool     `json:"public"`
+	MaxPlayers     int      `json:"maxPlayers"`
+
+	CreatedAt time.Time `json:"createdAt"`
+}
@@ -0,0 +1,112 @@
+package database
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/bloops-games/bloops/internal/byteutil"
+	"github.com/bloops-games/bloops/internal/database"
+	"github.com/bloops-games/bloops/internal/database/gamelog/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+var ErrNotFound = fmt.Errorf("not found")
+
+// every game log is a nested bucket with events keyed by the sequence number
+const bucket = "gamelogs"
+
+func New(db *database.DB) *DB {
+	return &DB{sDB: db}
+}
+
+type DB struct {
+	sDB *database.DB
+}
+
+// Append adds the event to the end of the game log
+func (db *DB) Append(logID string, e model.Event) error {
+	bytes, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	if err := db.sDB.DB.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return fmt.Errorf("create bucket: %w", err)
+		}
+
+		b, err := root.CreateBucketIfNotExists([]byte(logID))
+		if err != nil {
+			return fmt.Errorf("create log bucket: %w", err)
+		}
+
+		seq, err := b.NextSequence()
+		if err != nil {
+			return fmt.Errorf("next sequence: %w", err)
+		}
+
+		if err := b.Put(byteutil.EncodeInt64ToBytes(int64(seq)), bytes); err != nil {
+			return fmt.Errorf("put to bucket error: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("update transaction error: %w", err)
+	}
+
+	return nil
+}
+
+// Fetch returns the events of the game log in the order they were appended
+func (db *DB) Fetch(logID string) ([]model.Event, error) {
+	var list []model.Event
+	if err := db.sDB.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(bucket))
+		if root == nil {
+			return ErrNotFound
+		}
+
+		b := root.Bucket([]byte(logID))
+		if b == nil {
+			return ErrNotFound
+		}
+
+		if err := b.ForEach(func(k, v []byte) error {
+			var e model.Event
+			if err := json.Unmarshal(v, &e); err != nil {
+				return fmt.Errorf("json unmarshal error, %w", err)
+			}
+			list = append(list, e)
+			return nil
+		}); err != nil {
+			return fmt.Errorf("bucket for each: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return list, nil
+}
+
+// FetchIDs returns ids of all stored game logs
+func (db *DB) FetchIDs() ([]string, error) {
+	var ids []string
+	if err := db.sDB.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(bucket))
+		if root == nil {
+			return nil
+		}
+
+		return root.ForEach(func(k, v []byte) error {
+			ids = append(ids, string(k))
+			return nil
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return ids, nil
+}
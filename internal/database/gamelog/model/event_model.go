@@ -0,0 +1,71 @@
+package model
+
+import "time"
+
+type EventKind string
+
+const (
+	EventKindStart        EventKind = "start"
+	EventKindResume       EventKind = "resume"
+	EventKindPlayerJoined EventKind = "playerJoined"
+	EventKindPlayerLeft   EventKind = "playerLeft"
+	EventKindLetter       EventKind = "letter"
+	EventKindBloops       EventKind = "bloops"
+	EventKindStop         EventKind = "stop"
+	EventKindVote         EventKind = "vote"
+	EventKindTeam         EventKind = "team"
+	EventKindPoints       EventKind = "points"
+)
+
+// Event is an entry of the append-only game log
+type Event struct {
+	Kind  EventKind `json:"kind"`
+	Round int       `json:"round"`
+	// index of the player in the game, offline players share the user id with their host
+	Player int       `json:"player"`
+	UserID int64     `json:"userId"`
+	At     time.Time `json:"at"`
+
+	// start
+	Seed      int64  `json:"seed,omitempty"`
+	Locale    string `json:"locale,omitempty"`
+	RoundsNum int    `json:"roundsNum,omitempty"`
+	VoteRule  uint8  `json:"voteRule,omitempty"`
+	// the points of the round are given for the typed answers
+	TypedAnswers bool `json:"typedAnswers,omitempty"`
+	// names of the teams of a team game
+	Teams []string `json:"teams,omitempty"`
+
+	// resume after a restart, the values drawn from the seed and the number of the rates of every player
+	// at the checkpoint the game is resumed from
+	Draws int64 `json:"draws,omitempty"`
+	Rates []int `json:"rates,omitempty"`
+
+	// player joined
+	FirstName string `json:"firstName,omitempty"`
+	ChatID    int64  `json:"chatId,omitempty"`
+	Offline   bool   `json:"offline,omitempty"`
+	// the user watches the game without playing
+	Spectator bool `json:"spectator,omitempty"`
+	// player joined or switched the team
+	Team int `json:"team,omitempty"`
+
+	// letter drawn
+	Letter string `json:"letter,omitempty"`
+
+	// bloops dropped
+	BloopsName string `json:"bloopsName,omitempty"`
+	Points     int    `json:"points,omitempty"`
+
+	// timer stop, Seconds are left on the timer, bloops Seconds for the bloops event
+	Seconds  int           `json:"seconds,omitempty"`
+	Duration time.Duration `json:"duration,omitempty"`
+	// number of the typed answers at the timer stop, the number of the accepted ones after the vote
+	Answers int `json:"answers,omitempty"`
+	// number of the typed answers known by the dictionary
+	KnownAnswers int `json:"knownAnswers,omitempty"`
+
+	// vote
+	ThumbUp   int `json:"thumbUp,omitempty"`
+	ThumbDown int `json:"thumbDown,omitempty"`
+}
@@ -0,0 +1,359 @@
+package database
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/database"
+	"github.com/bloops-games/bloops/internal/database/leaderboard/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	ErrNotFound      = fmt.Errorf("not found")
+	ErrUnknownMetric = fmt.Errorf("unknown metric")
+)
+
+// every season is a nested bucket with the scores of the users and a sorted index for every metric,
+// the keys of the index are the value in the order of the ranking followed by the user id
+const (
+	bucket       = "leaderboards"
+	scoresBucket = "scores"
+	indexPrefix  = "index:"
+)
+
+// New returns the leaderboards that keep the number of the latest monthly seasons, all if it is not positive
+func New(db *database.DB, seasons int) *DB {
+	return &DB{sDB: db, seasons: seasons}
+}
+
+type DB struct {
+	sDB     *database.DB
+	seasons int
+}
+
+// Add adds the result of the game to the all-time and to the monthly leaderboards,
+// the oldest monthly seasons are removed when a new season starts
+func (db *DB) Add(result model.Score, at time.Time) error {
+	if err := db.sDB.DB.Update(func(tx *bolt.Tx) error {
+		root, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return fmt.Errorf("create bucket: %w", err)
+		}
+
+		for _, season := range []string{model.SeasonAllTime, model.Season(at)} {
+			b := root.Bucket([]byte(season))
+			if b == nil {
+				if b, err = root.CreateBucket([]byte(season)); err != nil {
+					return fmt.Errorf("create season bucket: %w", err)
+				}
+
+				if err := db.rollover(root); err != nil {
+					return fmt.Errorf("rollover: %w", err)
+				}
+			}
+
+			if err := addScore(b, result); err != nil {
+				return fmt.Errorf("add score: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("update transaction error: %w", err)
+	}
+
+	return nil
+}
+
+// rollover removes the monthly seasons beyond the kept number
+func (db *DB) rollover(root *bolt.Bucket) error {
+	if db.seasons <= 0 {
+		return nil
+	}
+
+	// the monthly seasons are named by the year and the month, so the keys are sorted by time
+	var seasons [][]byte
+	if err := root.ForEach(func(k, v []byte) error {
+		if v == nil && string(k) != model.SeasonAllTime {
+			seasons = append(seasons, append([]byte(nil), k...))
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("bucket for each: %w", err)
+	}
+
+	for ; len(seasons) > db.seasons; seasons = seasons[1:] {
+		if err := root.DeleteBucket(seasons[0]); err != nil {
+			return fmt.Errorf("delete season bucket: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// addScore merges the result of the game into the score of the user and moves the user in the indexes
+func addScore(b *bolt.Bucket, result model.Score) error {
+	scores, err := b.CreateBucketIfNotExists([]byte(scoresBucket))
+	if err != nil {
+		return fmt.Errorf("create scores bucket: %w", err)
+	}
+
+	score := model.Score{UserID: result.UserID}
+	if v := scores.Get(userKey(result.UserID)); v != nil {
+		if err := json.Unmarshal(v, &score); err != nil {
+			return fmt.Errorf("json unmarshal error, %w", err)
+		}
+	}
+
+	if err := updateIndexes(b, score, false); err != nil {
+		return err
+	}
+
+	score = mergeScore(score, result)
+	if err := updateIndexes(b, score, true); err != nil {
+		return err
+	}
+
+	bytes, err := json.Marshal(score)
+	if err != nil {
+		return fmt.Errorf("marshal: %w", err)
+	}
+
+	if err := scores.Put(userKey(score.UserID), bytes); err != nil {
+		return fmt.Errorf("put to bucket error: %w", err)
+	}
+
+	return nil
+}
+
+func mergeScore(score, result model.Score) model.Score {
+	if result.FirstName != "" {
+		score.FirstName = result.FirstName
+	}
+
+	if result.Username != "" {
+		score.Username = result.Username
+	}
+
+	score.Stars += result.Stars
+	score.Points += result.Points
+	if result.BestTime > 0 && (score.BestTime == 0 || result.BestTime < score.BestTime) {
+		score.BestTime = result.BestTime
+	}
+
+BloopsLoop:
+	for _, bloops := range result.Bloops {
+		for _, collected := range score.Bloops {
+			if bloops == collected {
+				continue BloopsLoop
+			}
+		}
+
+		score.Bloops = append(score.Bloops, bloops)
+	}
+
+	return score
+}
+
+// updateIndexes puts the score to the indexes of the metrics it is ranked by or deletes it from them
+func updateIndexes(b *bolt.Bucket, score model.Score, put bool) error {
+	for _, metric := range model.Metrics {
+		value, ok := score.Value(metric)
+		if !ok {
+			continue
+		}
+
+		idx, err := b.CreateBucketIfNotExists([]byte(indexPrefix + string(metric)))
+		if err != nil {
+			return fmt.Errorf("create index bucket: %w", err)
+		}
+
+		key := indexKey(metric, value, score.UserID)
+		if put {
+			err = idx.Put(key, nil)
+		} else {
+			err = idx.Delete(key)
+		}
+
+		if err != nil {
+			return fmt.Errorf("update index %s: %w", metric, err)
+		}
+	}
+
+	return nil
+}
+
+// Top returns the first users of the leaderboard
+func (db *DB) Top(season string, metric model.Metric, limit int) ([]model.Entry, error) {
+	if !knownMetric(metric) {
+		return nil, fmt.Errorf("%s: %w", metric, ErrUnknownMetric)
+	}
+
+	var list []model.Entry
+	if err := db.view(season, func(b *bolt.Bucket) error {
+		idx, scores := b.Bucket([]byte(indexPrefix+string(metric))), b.Bucket([]byte(scoresBucket))
+		if idx == nil || scores == nil {
+			return nil
+		}
+
+		c := idx.Cursor()
+		for k, _ := c.First(); k != nil && len(list) < limit; k, _ = c.Next() {
+			entry, err := readEntry(scores, metric, k)
+			if err != nil {
+				return err
+			}
+
+			entry.Rank = len(list) + 1
+			list = append(list, entry)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return list, nil
+}
+
+// Position returns the place of the user in the leaderboard
+func (db *DB) Position(season string, metric model.Metric, userID int64) (model.Entry, error) {
+	var entry model.Entry
+	if !knownMetric(metric) {
+		return entry, fmt.Errorf("%s: %w", metric, ErrUnknownMetric)
+	}
+
+	if err := db.view(season, func(b *bolt.Bucket) error {
+		idx, scores := b.Bucket([]byte(indexPrefix+string(metric))), b.Bucket([]byte(scoresBucket))
+		if idx == nil || scores == nil {
+			return ErrNotFound
+		}
+
+		var score model.Score
+		v := scores.Get(userKey(userID))
+		if v == nil {
+			return ErrNotFound
+		}
+
+		if err := json.Unmarshal(v, &score); err != nil {
+			return fmt.Errorf("json unmarshal error, %w", err)
+		}
+
+		value, ok := score.Value(metric)
+		if !ok {
+			return ErrNotFound
+		}
+
+		key := indexKey(metric, value, userID)
+		rank := 1
+		c := idx.Cursor()
+		for k, _ := c.First(); k != nil && string(k) != string(key); k, _ = c.Next() {
+			rank++
+		}
+
+		entry = model.Entry{Rank: rank, Value: value, Score: score}
+
+		return nil
+	}); err != nil {
+		return entry, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return entry, nil
+}
+
+// Seasons returns the seasons of the leaderboards, the all-time season first and then the latest months
+func (db *DB) Seasons() ([]string, error) {
+	var list []string
+	if err := db.sDB.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(bucket))
+		if root == nil {
+			return nil
+		}
+
+		return root.ForEach(func(k, v []byte) error {
+			if v == nil {
+				list = append(list, string(k))
+			}
+
+			return nil
+		})
+	}); err != nil {
+		return nil, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		if list[i] == model.SeasonAllTime || list[j] == model.SeasonAllTime {
+			return list[i] == model.SeasonAllTime
+		}
+
+		return list[i] > list[j]
+	})
+
+	return list, nil
+}
+
+// view runs the function with the bucket of the season
+func (db *DB) view(season string, fn func(b *bolt.Bucket) error) error {
+	return db.sDB.DB.View(func(tx *bolt.Tx) error {
+		root := tx.Bucket([]byte(bucket))
+		if root == nil {
+			return ErrNotFound
+		}
+
+		b := root.Bucket([]byte(season))
+		if b == nil {
+			return ErrNotFound
+		}
+
+		return fn(b)
+	})
+}
+
+func readEntry(scores *bolt.Bucket, metric model.Metric, key []byte) (model.Entry, error) {
+	var entry model.Entry
+	v := scores.Get(key[8:])
+	if v == nil {
+		return entry, fmt.Errorf("score of the index key %x: %w", key, ErrNotFound)
+	}
+
+	if err := json.Unmarshal(v, &entry.Score); err != nil {
+		return entry, fmt.Errorf("json unmarshal error, %w", err)
+	}
+
+	entry.Value, _ = entry.Score.Value(metric)
+
+	return entry, nil
+}
+
+func knownMetric(metric model.Metric) bool {
+	for _, m := range model.Metrics {
+		if m == metric {
+			return true
+		}
+	}
+
+	return false
+}
+
+func userKey(userID int64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, uint64(userID))
+	return b
+}
+
+// indexKey orders the values of the metric from the best to the worst, the equal values by the user id
+func indexKey(metric model.Metric, value, userID int64) []byte {
+	order := uint64(value)
+	if metric != model.MetricTime {
+		order = ^order
+	}
+
+	b := make([]byte, 16)
+	binary.BigEndian.PutUint64(b, order)
+	binary.BigEndian.PutUint64(b[8:], uint64(userID))
+	return b
+}
@@ -0,0 +1,128 @@
+package database
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bloops-games/bloops/internal/database"
+	"github.com/bloops-games/bloops/internal/database/leaderboard/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+func newTestDB(t *testing.T, seasons int) *DB {
+	t.Helper()
+
+	bdb, err := bolt.Open(filepath.Join(t.TempDir(), "db"), 0600, nil)
+	if err != nil {
+		t.Fatalf("bolt open: %v", err)
+	}
+
+	t.Cleanup(func() { _ = bdb.Close() })
+
+	return New(&database.DB{DB: bdb}, seasons)
+}
+
+func TestLeaderboard(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, 2)
+	at := time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC)
+	results := []model.Score{
+		{UserID: 1, FirstName: "Ann", Stars: 1, Points: 30, BestTime: 20 * time.Second, Bloops: []string{"Rush"}},
+		{UserID: 2, FirstName: "Bob", Points: 50, BestTime: 10 * time.Second},
+		{UserID: 1, Stars: 1, Points: 5, BestTime: 25 * time.Second, Bloops: []string{"Rush", "Flamingo"}},
+		{UserID: 3, FirstName: "Cid", Points: -5},
+	}
+
+	for _, result := range results {
+		if err := db.Add(result, at); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+	}
+
+	testCases := []struct {
+		metric   model.Metric
+		expected []int64
+		values   []int64
+	}{
+		{metric: model.MetricStars, expected: []int64{1}, values: []int64{2}},
+		{metric: model.MetricPoints, expected: []int64{2, 1}, values: []int64{50, 35}},
+		{metric: model.MetricTime, expected: []int64{2, 1}, values: []int64{int64(10 * time.Second), int64(20 * time.Second)}},
+		{metric: model.MetricBloops, expected: []int64{1}, values: []int64{2}},
+	}
+
+	for _, tc := range testCases {
+		for _, season := range []string{model.SeasonAllTime, model.Season(at)} {
+			entries, err := db.Top(season, tc.metric, 10)
+			if err != nil {
+				t.Fatalf("top: %v", err)
+			}
+
+			if len(entries) != len(tc.expected) {
+				t.Fatalf("%s %s: expected %d entries, got %+v", season, tc.metric, len(tc.expected), entries)
+			}
+
+			for i, entry := range entries {
+				if entry.Score.UserID != tc.expected[i] || entry.Value != tc.values[i] || entry.Rank != i+1 {
+					t.Errorf("%s %s: expected user %d with %d at %d, got %+v", season, tc.metric, tc.expected[i], tc.values[i], i+1, entry)
+				}
+			}
+		}
+	}
+
+	entry, err := db.Position(model.SeasonAllTime, model.MetricPoints, 1)
+	if err != nil {
+		t.Fatalf("position: %v", err)
+	}
+
+	if entry.Rank != 2 || entry.Score.FirstName != "Ann" {
+		t.Errorf("expected Ann to be second, got %+v", entry)
+	}
+
+	if _, err := db.Position(model.SeasonAllTime, model.MetricPoints, 3); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the negative points not to be ranked, got %v", err)
+	}
+
+	if _, err := db.Top(model.SeasonAllTime, "unknown", 10); !errors.Is(err, ErrUnknownMetric) {
+		t.Errorf("expected the unknown metric, got %v", err)
+	}
+}
+
+func TestLeaderboardRollover(t *testing.T) {
+	t.Parallel()
+
+	db := newTestDB(t, 2)
+	start := time.Date(2026, 8, 15, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3; i++ {
+		if err := db.Add(model.Score{UserID: 1, Stars: 1}, start.AddDate(0, i, 0)); err != nil {
+			t.Fatalf("add: %v", err)
+		}
+	}
+
+	seasons, err := db.Seasons()
+	if err != nil {
+		t.Fatalf("seasons: %v", err)
+	}
+
+	expected := []string{model.SeasonAllTime, "2026-10", "2026-09"}
+	if len(seasons) != len(expected) {
+		t.Fatalf("expected seasons %v, got %v", expected, seasons)
+	}
+
+	for i := range expected {
+		if seasons[i] != expected[i] {
+			t.Fatalf("expected seasons %v, got %v", expected, seasons)
+		}
+	}
+
+	if _, err := db.Top("2026-08", model.MetricStars, 10); !errors.Is(err, ErrNotFound) {
+		t.Errorf("expected the oldest season to be removed, got %v", err)
+	}
+
+	entry, err := db.Position(model.SeasonAllTime, model.MetricStars, 1)
+	if err != nil || entry.Value != 3 {
+		t.Errorf("expected 3 stars of all time, got %+v %v", entry, err)
+	}
+}
@@ -0,0 +1,61 @@
+package model
+
+import "time"
+
+// Metric is the value the users are ranked by
+type Metric string
+
+const (
+	MetricStars  Metric = "stars"
+	MetricPoints Metric = "points"
+	// the best round time, the fastest round is the first
+	MetricTime   Metric = "time"
+	MetricBloops Metric = "bloops"
+)
+
+// Metrics are all the leaderboards in the order they are shown
+var Metrics = []Metric{MetricStars, MetricPoints, MetricTime, MetricBloops}
+
+// SeasonAllTime is the season that never ends
+const SeasonAllTime = "all"
+
+// Season returns the monthly season of the time
+func Season(t time.Time) string {
+	return t.UTC().Format("2006-01")
+}
+
+// Score is the total of the user in the season
+type Score struct {
+	UserID    int64  `json:"userId"`
+	FirstName string `json:"firstName"`
+	Username  string `json:"username"`
+	Stars     int    `json:"stars"`
+	Points    int    `json:"points"`
+	// zero if the user has no completed round
+	BestTime time.Duration `json:"bestTime"`
+	// distinct bloopses collected by the user
+	Bloops []string `json:"bloops"`
+}
+
+// Value returns the value of the score the user is ranked by, false if the user is not ranked by the metric
+func (s Score) Value(metric Metric) (int64, bool) {
+	switch metric {
+	case MetricStars:
+		return int64(s.Stars), s.Stars > 0
+	case MetricPoints:
+		return int64(s.Points), s.Points > 0
+	case MetricTime:
+		return int64(s.BestTime), s.BestTime > 0
+	case MetricBloops:
+		return int64(len(s.Bloops)), len(s.Bloops) > 0
+	}
+
+	return 0, false
+}
+
+// Entry is the place of the user in the leaderboard
+type Entry struct {
+	Rank  int   `json:"rank"`
+	Value int64 `json:"value"`
+	Score Score `json:"score"`
+}
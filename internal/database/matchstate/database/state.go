@@ -108,3 +108,27 @@ func (db *DB) Add(m model.State) error {
 
 	return nil
 }
+
+func (db *DB) Delete(code int64) error {
+	tx, err := db.sDB.DB.Begin(true)
+	if err != nil {
+		return fmt.Errorf("starting transaction: %w", err)
+	}
+
+	defer tx.Rollback() // nolint
+
+	b := tx.Bucket([]byte(prefix))
+	if b == nil {
+		return ErrBucketNotFound
+	}
+
+	if err := b.Delete(byteutil.EncodeInt64ToBytes(code)); err != nil {
+		return fmt.Errorf("delete from bucket: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package model
 
 import (
+	"math"
 	"strconv"
 
 	userModel "github.com/bloops-games/bloops/internal/database/user/model"
@@ -26,6 +27,14 @@ func NewPlayer(chatID int64, user userModel.User, offline bool) *Player {
 	}
 }
 
+// NewSpectator creates a user who receives the game messages, but never takes a turn
+func NewSpectator(chatID int64, user userModel.User) *Player {
+	player := NewPlayer(chatID, user, false)
+	player.Spectator = true
+
+	return player
+}
+
 type Player struct {
 	User    userModel.User  `json:"user"`
 	State   PlayerStateKind `json:"state"`
@@ -33,6 +42,12 @@ type Player struct {
 	ChatID  int64           `json:"chatId"`
 	UserID  int64           `json:"userID"`
 	Rates   []*Rate         `json:"rates"`
+	// watches the game without playing
+	Spectator bool `json:"spectator"`
+	// number of the team starting from 1, zero if the game is played without teams
+	Team int `json:"team"`
+	// the rating of the user is shown next to the name
+	ShowRating bool `json:"showRating"`
 }
 
 func (p *Player) IsPlaying() bool {
@@ -47,11 +62,23 @@ func (p *Player) FormatFirstName() string {
 	}()
 
 	buf.WriteString(p.User.FirstName)
-	if !p.Offline && p.User.Stars > 0 {
+	rated := p.ShowRating && p.User.Rating.IsRated()
+	if !p.Offline && (p.User.Stars > 0 || rated) {
 		buf.WriteString(" - ")
 		buf.WriteString("(")
-		buf.WriteString(strconv.Itoa(p.User.Stars))
-		buf.WriteString(emoji.Star.String())
+		if p.User.Stars > 0 {
+			buf.WriteString(strconv.Itoa(p.User.Stars))
+			buf.WriteString(emoji.Star.String())
+		}
+
+		if rated {
+			if p.User.Stars > 0 {
+				buf.WriteString(" ")
+			}
+
+			buf.WriteString(strconv.Itoa(int(math.Round(p.User.Rating.Value))))
+			buf.WriteString(emoji.ChartIncreasing.String())
+		}
 		buf.WriteString(")")
 	}
 
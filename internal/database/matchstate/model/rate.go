@@ -2,10 +2,41 @@ package model
 
 import "time"
 
+type Ballot uint8
+
+const (
+	BallotThumbUp Ballot = iota + 1
+	BallotThumbDown
+	BallotAbstain
+)
+
+// Vote is a ballot cast by the player, every change of the ballot is recorded as a new vote
+type Vote struct {
+	UserID int64     `json:"userId"`
+	Ballot Ballot    `json:"ballot"`
+	At     time.Time `json:"at"`
+	// first name of the offline player the host voted for
+	Name string `json:"name,omitempty"`
+	// the typed answer the ballot is cast on, thumb down rejects the answer and thumb up takes it back
+	Word string `json:"word,omitempty"`
+}
+
+// Answer is a word typed by the player for the category
+type Answer struct {
+	Category string `json:"category"`
+	Word     string `json:"word"`
+	// the dictionary knows the word, the points for it are not voted on
+	Known bool `json:"known"`
+	// the voters rejected the word, it gets no points
+	Rejected bool `json:"rejected,omitempty"`
+}
+
 type Rate struct {
 	Duration   time.Duration `json:"duration"`
 	Points     int           `json:"points"`
 	Completed  bool          `json:"completed"`
 	Bloops     bool          `json:"bloopsbot"`
 	BloopsName string        `json:"bloopsName"`
+	Votes      []Vote        `json:"votes"`
+	Answers    []Answer      `json:"answers"`
 }
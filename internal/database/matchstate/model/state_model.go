@@ -17,10 +17,34 @@ type State struct {
 	Bloopses   []resource.Bloops `json:"bloopses"`
 	Vote       bool              `json:"vote"`
 	Code       int64             `json:"code"`
+	ChatID     int64             `json:"chatId"`
+	Locale     string            `json:"locale"`
+
+	BloopsChance int   `json:"bloopsChance"`
+	BloopsRepeat bool  `json:"bloopsRepeat"`
+	Seed         int64 `json:"seed"`
+	// values drawn from the seed when the state was stored
+	Draws int64 `json:"draws"`
+
+	VoteRule    resource.VoteRule `json:"voteRule"`
+	VoteTimeout int               `json:"voteTimeout"`
+
+	TypedAnswers   bool     `json:"typedAnswers"`
+	SpectatorsVote bool     `json:"spectatorsVote"`
+	Teams          []string `json:"teams"`
+	OfflineVote    bool     `json:"offlineVote"`
+	Private        bool     `json:"private"`
+	PIN            string   `json:"pin"`
+	Public         bool     `json:"public"`
+	MaxPlayers     int      `json:"maxPlayers"`
 
 	State        uint8     `json:"state"`
 	CurrRoundIdx int       `json:"currRoundIdx"`
 	Players      []*Player `json:"players"`
+	// index of the player whose turn was interrupted, -1 between turns
+	CurrPlayerIdx int `json:"currPlayerIdx"`
 
 	CreatedAt time.Time `json:"createdAt"`
+	// total time the game has been paused, it does not count towards the timeout
+	PausedFor time.Duration `json:"pausedFor"`
 }
@@ -0,0 +1,146 @@
+package database
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"math/big"
+
+	"github.com/bloops-games/bloops/internal/byteutil"
+	"github.com/bloops-games/bloops/internal/database"
+	"github.com/bloops-games/bloops/internal/database/pack/model"
+	bolt "go.etcd.io/bbolt"
+)
+
+var (
+	ErrNotFound  = fmt.Errorf("not found")
+	ErrExhausted = fmt.Errorf("no free pack code found")
+)
+
+const bucket = "packs"
+
+// the share codes are random nine-digit numbers, so the codes of the other packs can not be guessed
+const (
+	codeMin      = 100000000
+	codeMax      = 999999999
+	codeAttempts = 16
+)
+
+func New(db *database.DB) *DB {
+	return &DB{sDB: db}
+}
+
+type DB struct {
+	sDB *database.DB
+}
+
+// Add stores a new pack under a fresh share code and returns the stored pack
+func (db *DB) Add(m model.Pack) (model.Pack, error) {
+	tx, err := db.sDB.DB.Begin(true)
+	if err != nil {
+		return m, fmt.Errorf("starting transaction: %w", err)
+	}
+
+	defer tx.Rollback() // nolint
+
+	b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+	if err != nil {
+		return m, fmt.Errorf("can not create bucket: %w", err)
+	}
+
+	code, err := freeCode(b)
+	if err != nil {
+		return m, fmt.Errorf("free code: %w", err)
+	}
+
+	m.Code = code
+
+	bytes, err := json.Marshal(m)
+	if err != nil {
+		return m, fmt.Errorf("marshal: %w", err)
+	}
+
+	if err := b.Put(byteutil.EncodeInt64ToBytes(m.Code), bytes); err != nil {
+		return m, fmt.Errorf("put to bucket error: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return m, fmt.Errorf("committing transaction: %w", err)
+	}
+
+	return m, nil
+}
+
+// freeCode returns a random share code that is not used by another pack
+func freeCode(b *bolt.Bucket) (int64, error) {
+	for i := 0; i < codeAttempts; i++ {
+		n, err := rand.Int(rand.Reader, big.NewInt(codeMax-codeMin+1))
+		if err != nil {
+			return 0, fmt.Errorf("rand int: %w", err)
+		}
+
+		code := n.Int64() + codeMin
+		if b.Get(byteutil.EncodeInt64ToBytes(code)) == nil {
+			return code, nil
+		}
+	}
+
+	return 0, ErrExhausted
+}
+
+func (db *DB) Fetch(code int64) (model.Pack, error) {
+	var pack model.Pack
+	if err := db.sDB.DB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return ErrNotFound
+		}
+
+		bytes := b.Get(byteutil.EncodeInt64ToBytes(code))
+		if len(bytes) == 0 {
+			return ErrNotFound
+		}
+
+		if err := json.Unmarshal(bytes, &pack); err != nil {
+			return fmt.Errorf("json unmarshal error, %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return pack, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return pack, nil
+}
+
+// FetchByAuthor returns all packs created by the user
+func (db *DB) FetchByAuthor(authorID int64) ([]model.Pack, error) {
+	var list []model.Pack
+	if err := db.sDB.DB.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+
+		if err := b.ForEach(func(k, v []byte) error {
+			var pack model.Pack
+			if err := json.Unmarshal(v, &pack); err != nil {
+				return fmt.Errorf("json unmarshal error, %w", err)
+			}
+
+			if pack.AuthorID == authorID {
+				list = append(list, pack)
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("bucket for each: %w", err)
+		}
+
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("view transaction error: %w", err)
+	}
+
+	return list, nil
+}
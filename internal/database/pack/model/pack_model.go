@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// Pack is a user-defined set of categories and letters that can be picked in the builder
+type Pack struct {
+	// Code is a short code for sharing the pack with other users
+	Code       int64     `json:"code"`
+	AuthorID   int64     `json:"authorId"`
+	Name       string    `json:"name"`
+	Locale     string    `json:"locale"`
+	Categories []string  `json:"categories"`
+	Letters    []string  `json:"letters"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
@@ -0,0 +1,29 @@
+package model
+
+import "time"
+
+// Rating is the skill of the user rated by the places in the games with other players
+type Rating struct {
+	Value      float64 `json:"value"`
+	Deviation  float64 `json:"deviation"`
+	Volatility float64 `json:"volatility"`
+	// number of the rated games, zero if the user is not rated yet
+	Games int `json:"games"`
+	// latest changes of the rating, the oldest first
+	History []RatingChange `json:"history,omitempty"`
+}
+
+// RatingChange is the rating of the user after the game
+type RatingChange struct {
+	At        time.Time `json:"at"`
+	Value     float64   `json:"value"`
+	Deviation float64   `json:"deviation"`
+	// place of the user in the game starting from 1
+	Place   int `json:"place"`
+	Players int `json:"players"`
+}
+
+// IsRated checks the user has played a rated game
+func (r Rating) IsRated() bool {
+	return r.Games > 0
+}
@@ -20,4 +20,5 @@ type User struct {
 	Status       Status    `json:"banned"`
 	Stars        int
 	Bloops       int
+	Rating       Rating `json:"rating"`
 }
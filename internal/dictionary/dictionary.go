@@ -0,0 +1,103 @@
+package dictionary
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/bloops-games/bloops/internal/cache"
+)
+
+// Dictionary knows which words belong to the categories of the game
+type Dictionary interface {
+	// Known reports whether the word belongs to the category, false if the category has no word list
+	Known(locale, category, word string) (bool, error)
+}
+
+func NewFile(dir string, names map[string]string, cache cache.Cache) *File {
+	return &File{dir: dir, names: names, cache: cache}
+}
+
+var _ Dictionary = (*File)(nil)
+
+// File reads the word lists from the files <dir>/<locale>/<name>.txt, one word per line,
+// the names of the lists are looked up by the category text
+type File struct {
+	dir   string
+	names map[string]string
+	cache cache.Cache
+}
+
+func (d *File) Known(locale, category, word string) (bool, error) {
+	name, ok := d.names[category]
+	if !ok {
+		return false, nil
+	}
+
+	words, err := d.words(locale, name)
+	if err != nil {
+		return false, fmt.Errorf("word list %s/%s: %w", locale, name, err)
+	}
+
+	_, ok = words[Normalize(word)]
+
+	return ok, nil
+}
+
+func (d *File) words(locale, name string) (map[string]struct{}, error) {
+	key := locale + "/" + name
+	if v, ok := d.cache.Get(key); ok {
+		return v.(map[string]struct{}), nil
+	}
+
+	words, err := readWords(filepath.Join(d.dir, locale, name+".txt"))
+	if err != nil {
+		return nil, err
+	}
+
+	d.cache.Add(key, words)
+
+	return words, nil
+}
+
+// readWords reads the normalized words of the file, the empty lines and the lines starting with # are skipped.
+// A missing file gives an empty list
+func readWords(path string) (map[string]struct{}, error) {
+	words := map[string]struct{}{}
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return words, nil
+		}
+
+		return nil, fmt.Errorf("open: %w", err)
+	}
+
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := Normalize(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		words[line] = struct{}{}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("scan: %w", err)
+	}
+
+	return words, nil
+}
+
+// Normalize lowercases the word, collapses the spaces and replaces ё with е
+func Normalize(word string) string {
+	word = strings.ToLower(strings.Join(strings.Fields(word), " "))
+
+	return strings.ReplaceAll(word, "ё", "е")
+}
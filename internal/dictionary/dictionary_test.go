@@ -0,0 +1,62 @@
+package dictionary
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/bloops-games/bloops/internal/cache"
+)
+
+func TestFileKnown(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	if err := os.Mkdir(filepath.Join(dir, "ru"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	list := "# cities\nМосква\n\nНижний  Новгород\nОрёл\n"
+	if err := os.WriteFile(filepath.Join(dir, "ru", "cities.txt"), []byte(list), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := cache.NewLRU(8)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	d := NewFile(dir, map[string]string{"Город": "cities", "Животное": "animals"}, c)
+	testCases := []struct {
+		name     string
+		locale   string
+		category string
+		word     string
+		expected bool
+	}{
+		{name: "known", locale: "ru", category: "Город", word: "москва", expected: true},
+		{name: "spaces", locale: "ru", category: "Город", word: " нижний новгород", expected: true},
+		{name: "yo", locale: "ru", category: "Город", word: "Орел", expected: true},
+		{name: "unknown", locale: "ru", category: "Город", word: "Мадрид", expected: false},
+		{name: "comment", locale: "ru", category: "Город", word: "# cities", expected: false},
+		{name: "no_list_file", locale: "ru", category: "Животное", word: "Медведь", expected: false},
+		{name: "no_locale", locale: "en", category: "Город", word: "Москва", expected: false},
+		{name: "no_list", locale: "ru", category: "Бренд", word: "Мерседес", expected: false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			known, err := d.Known(tc.locale, tc.category, tc.word)
+			if err != nil {
+				t.Fatalf("known: %v", err)
+			}
+
+			if known != tc.expected {
+				t.Errorf("got %t, expected %t", known, tc.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"crypto/subtle"
+	"net/http"
+	"strings"
+)
+
+const bearerPrefix = "Bearer "
+
+// Credentials of the REST API clients, basic auth is accepted when both the username and the password are set
+// and bearer auth when the token is set
+type Credentials struct {
+	Username string
+	Password string
+	Token    string
+}
+
+func (c Credentials) Empty() bool {
+	return !c.basic() && c.Token == ""
+}
+
+// basic checks the basic auth is configured, the username without the password is never accepted
+func (c Credentials) basic() bool {
+	return c.Username != "" && c.Password != ""
+}
+
+// HandleAuth passes the request to the next handler only if it has valid basic or bearer credentials
+func HandleAuth(creds Credentials, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !creds.authorized(r) {
+			w.Header().Set("WWW-Authenticate", `Basic realm="bloops"`)
+			http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (c Credentials) authorized(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if c.Token != "" && strings.HasPrefix(header, bearerPrefix) {
+		return equal(strings.TrimPrefix(header, bearerPrefix), c.Token)
+	}
+
+	if c.basic() {
+		username, password, ok := r.BasicAuth()
+		return ok && equal(username, c.Username) && equal(password, c.Password)
+	}
+
+	return false
+}
+
+func equal(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
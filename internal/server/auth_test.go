@@ -0,0 +1,81 @@
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleAuth(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		creds    Credentials
+		setAuth  func(r *http.Request)
+		expected int
+	}{
+		{
+			name:     "basic",
+			creds:    Credentials{Username: "admin", Password: "secret"},
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("admin", "secret") },
+			expected: http.StatusOK,
+		},
+		{
+			name:     "basic_wrong_password",
+			creds:    Credentials{Username: "admin", Password: "secret"},
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("admin", "wrong") },
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "bearer",
+			creds:    Credentials{Token: "token"},
+			setAuth:  func(r *http.Request) { r.Header.Set("Authorization", "Bearer token") },
+			expected: http.StatusOK,
+		},
+		{
+			name:     "bearer_wrong_token",
+			creds:    Credentials{Username: "admin", Password: "secret", Token: "token"},
+			setAuth:  func(r *http.Request) { r.Header.Set("Authorization", "Bearer wrong") },
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "basic_not_configured",
+			creds:    Credentials{Token: "token"},
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("", "") },
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "basic_without_password",
+			creds:    Credentials{Username: "admin"},
+			setAuth:  func(r *http.Request) { r.SetBasicAuth("admin", "") },
+			expected: http.StatusUnauthorized,
+		},
+		{
+			name:     "no_credentials",
+			creds:    Credentials{Username: "admin", Password: "secret"},
+			setAuth:  func(r *http.Request) {},
+			expected: http.StatusUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			handler := HandleAuth(tc.creds, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			}))
+
+			r := httptest.NewRequest(http.MethodGet, "/api/sessions", nil)
+			tc.setAuth(r)
+			w := httptest.NewRecorder()
+			handler.ServeHTTP(w, r)
+
+			if w.Code != tc.expected {
+				t.Errorf("got status %d, expected %d", w.Code, tc.expected)
+			}
+		})
+	}
+}